@@ -0,0 +1,254 @@
+package main
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// gitOption is an option of a git command that takes a value. Options
+// without value do not need to be declared.
+type gitOption struct {
+	short    byte
+	long     string
+	optional bool // the value must be attached: -Skey, --gpg-sign=key
+}
+
+// gitArg is an option with its value, or a positional argument when opt is
+// nil. The value is args[index][offset:], so it can be replaced in place.
+type gitArg struct {
+	opt    *gitOption
+	value  string
+	index  int
+	offset int
+}
+
+func (a gitArg) is(long string) bool { return a.opt != nil && a.opt.long == long }
+
+func (a gitArg) set(args []string, value string) {
+	args[a.index] = args[a.index][:a.offset] + value
+}
+
+var commitOptions = []*gitOption{
+	{'m', "message", false},
+	{'F', "file", false},
+	{'C', "reuse-message", false},
+	{'c', "reedit-message", false},
+	{'t', "template", false},
+	{0, "fixup", false},
+	{0, "squash", false},
+	{0, "author", false},
+	{0, "date", false},
+	{0, "cleanup", false},
+	{0, "trailer", false},
+	{0, "pathspec-from-file", false},
+	{'S', "gpg-sign", true},
+	{'u', "untracked-files", true},
+}
+
+// messageCommand describes how the wrapper adds the emoji to the message of a
+// git command
+type messageCommand struct {
+	options      []*gitOption
+	defaultType  string // applied when there is no type flag
+	ask          bool   // ask for the type when there is no type flag
+	hooks        bool   // the command runs prepare-commit-msg
+	messageAfter string // the positional arguments after it are the message
+	pair         bool   // --pair adds Co-authored-by trailers with --trailer
+}
+
+var messageCommands = map[string]messageCommand{
+	"commit": {options: commitOptions, ask: true, hooks: true, pair: true},
+	"merge":  {options: mergeOptions, defaultType: "merge", hooks: true},
+	"tag":    {options: tagOptions, ask: true},
+	"revert": {options: pickOptions, defaultType: "revert", hooks: true},
+
+	// keep the emoji of the original commit unless there is a type flag
+	"cherry-pick": {options: pickOptions, hooks: true},
+
+	// stash does not run hooks, only add the emoji with a type flag
+	"stash": {options: stashOptions, messageAfter: "save"},
+}
+
+var mergeOptions = []*gitOption{
+	{'m', "message", false},
+	{'F', "file", false},
+	{'s', "strategy", false},
+	{'X', "strategy-option", false},
+	{0, "cleanup", false},
+	{0, "into-name", false},
+	{'S', "gpg-sign", true},
+}
+
+var tagOptions = []*gitOption{
+	{'m', "message", false},
+	{'F', "file", false},
+	{'u', "local-user", false},
+	{0, "cleanup", false},
+	{0, "sort", false},
+	{0, "format", false},
+	{0, "color", true},
+	{'n', "", true},
+}
+
+// -m is the parent number of a merge commit for revert and cherry-pick
+var pickOptions = []*gitOption{
+	{'m', "mainline", false},
+	{'s', "strategy", false},
+	{'X', "strategy-option", false},
+	{0, "cleanup", false},
+	{'S', "gpg-sign", true},
+}
+
+var stashOptions = []*gitOption{
+	{'m', "message", false},
+	{0, "pathspec-from-file", false},
+}
+
+var reTypeFlag = regexp.MustCompile(`^([a-z]+)(\d*)$`)
+
+// parseTypeSpec parses an alias with an optional icon index: ft, ft1
+func parseTypeSpec(s string) (_ *Type, idx int, ok bool) {
+	m := reTypeFlag.FindStringSubmatch(s)
+	if m == nil {
+		return nil, 0, false
+	}
+	typ := mapTypes[m[1]]
+	if typ == nil {
+		return nil, 0, false
+	}
+	if m[2] != "" {
+		idx, _ = strconv.Atoi(m[2])
+	}
+	if idx >= len(typ.Icons) {
+		return nil, 0, false
+	}
+	return typ, idx, true
+}
+
+// parseGitArgs parses the arguments of a git command. The type flags (-feat,
+// --feat, -ft1) are removed from the arguments and returned separately: the
+// first one is the primary type, the emojis of the others are secondary tags
+// (-fix -test → 🐛🧪). Clustered short options (-am msg, -amsg) and the long forms
+// (--message msg, --message=msg) are supported. Arguments after "--" are
+// positional.
+func parseGitArgs(args []string, options []*gitOption) (_ []string, parsed []gitArg, flagType *Type, idx int, tags []string) {
+	findLong := func(name string) *gitOption {
+		for _, opt := range options {
+			if opt.long == name {
+				return opt
+			}
+		}
+		return nil
+	}
+	findShort := func(c byte) *gitOption {
+		for _, opt := range options {
+			if opt.short == c {
+				return opt
+			}
+		}
+		return nil
+	}
+
+	var out []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			for j := i + 1; j < len(args); j++ {
+				parsed = append(parsed, gitArg{value: args[j], index: len(out) + j - i})
+			}
+			out = append(out, args[i:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			parsed = append(parsed, gitArg{value: arg, index: len(out)})
+			out = append(out, arg)
+			continue
+		}
+		if typ, x, ok := parseTypeSpec(strings.TrimLeft(arg, "-")); ok {
+			if flagType == nil {
+				flagType, idx = typ, x
+			} else {
+				tags = append(tags, typ.Icons[x])
+			}
+			continue
+		}
+
+		index := len(out)
+		out = append(out, arg)
+		if strings.HasPrefix(arg, "--") {
+			name, _, hasValue := strings.Cut(arg[2:], "=")
+			opt := findLong(name)
+			switch {
+			case opt == nil:
+			case hasValue:
+				parsed = append(parsed, gitArg{opt: opt, value: arg[len(name)+3:], index: index, offset: len(name) + 3})
+			case opt.optional:
+				parsed = append(parsed, gitArg{opt: opt, index: index, offset: len(arg)})
+			case i+1 < len(args):
+				i++
+				parsed = append(parsed, gitArg{opt: opt, value: args[i], index: index + 1})
+				out = append(out, args[i])
+			}
+			continue
+		}
+		for k := 1; k < len(arg); k++ {
+			opt := findShort(arg[k])
+			if opt == nil {
+				continue
+			}
+			switch {
+			case k+1 < len(arg) || opt.optional:
+				parsed = append(parsed, gitArg{opt: opt, value: arg[k+1:], index: index, offset: k + 1})
+			case i+1 < len(args):
+				i++
+				parsed = append(parsed, gitArg{opt: opt, value: args[i], index: index + 1})
+				out = append(out, args[i])
+			}
+			break
+		}
+	}
+	return out, parsed, flagType, idx, tags
+}
+
+// parseCmdArgs parses the arguments of a git.emoji command. The options in
+// withValue take a value as "--opt value" or "--opt=value", the other options
+// are set to "true".
+func parseCmdArgs(args []string, withValue ...string) (opts map[string]string, positional []string) {
+	opts = make(map[string]string)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			positional = append(positional, arg)
+			continue
+		}
+		name, value, hasValue := strings.Cut(arg, "=")
+		switch {
+		case hasValue:
+			opts[name] = value
+		case slices.Contains(withValue, name):
+			if i+1 >= len(args) {
+				fatalf("missing value for %v", name)
+			}
+			i++
+			opts[name] = args[i]
+		default:
+			opts[name] = "true"
+		}
+	}
+	return opts, positional
+}
+
+// checkCmdOptions fails on unknown options
+func checkCmdOptions(cmd string, opts map[string]string, known ...string) {
+	for name := range opts {
+		if !slices.Contains(known, name) {
+			fatalf("git.emoji %v: unknown option %v", cmd, name)
+		}
+	}
+}
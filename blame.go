@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BlameLine is a line of "git blame --porcelain" with the commit that last
+// touched it
+type BlameLine struct {
+	Number  int
+	Content string
+	Commit  *Commit
+}
+
+// the lines not committed yet, grouped apart from the uncategorized commits
+var uncommitted = &Type{Name: "Not Committed Yet"}
+
+// execBlame prints the lines of the file prefixed with the emoji of the commit
+// that last touched them, then the share of the lines of each type:
+//
+//	git.emoji blame [--summary] [-L <range>] [<rev>] [--] <file>
+//
+//	💻 3e80fb6 (Alice 2024-05-02  1) package main
+//	🚧 daeb2c8 (Bob   2024-05-03  2) ...
+func execBlame(args []string) {
+	opts, positional := parseCmdArgs(args, "-L")
+	checkCmdOptions("blame", opts, "--summary", "-L")
+	if len(positional) < 1 || len(positional) > 2 {
+		fatalf("usage: git.emoji blame [--summary] [-L <range>] [<rev>] [--] <file>")
+	}
+	blameArgs := []string{"blame", "--porcelain"}
+	if opts["-L"] != "" {
+		blameArgs = append(blameArgs, "-L", opts["-L"])
+	}
+	blameArgs = append(blameArgs, positional[:len(positional)-1]...)
+	blameArgs = append(blameArgs, "--", positional[len(positional)-1])
+	out, errStr, err := execGitRaw(blameArgs...)
+	if err != nil {
+		fatalf("git blame: %v\n%s", err, errStr)
+	}
+	lines := parseBlame(out)
+
+	if opts["--summary"] == "" {
+		emojiWidth, authorWidth, numberWidth := 2, 0, 0
+		for _, line := range lines {
+			emojiWidth = max(emojiWidth, displayWidth(line.Commit.Emoji))
+			authorWidth = max(authorWidth, displayWidth(line.Commit.Author))
+			numberWidth = max(numberWidth, len(strconv.Itoa(line.Number)))
+		}
+		pad := func(s string, width int) string { return s + strings.Repeat(" ", width-displayWidth(s)) }
+		for _, line := range lines {
+			c := line.Commit
+			fmt.Printf("%s %s (%s %s %*d) %s\n", pad(c.Emoji, emojiWidth), c.ShortHash(), pad(c.Author, authorWidth),
+				c.Date.Format("2006-01-02"), numberWidth, line.Number, line.Content)
+		}
+		fmt.Println()
+	}
+	printBlameSummary(lines)
+}
+
+// parseBlame reads the output of "git blame --porcelain". The commit details
+// follow the first line of each commit only.
+func parseBlame(out string) (lines []*BlameLine) {
+	commits := make(map[string]*Commit)
+	var current *BlameLine
+	for _, row := range strings.Split(out, "\n") {
+		if content, ok := strings.CutPrefix(row, "\t"); ok {
+			if current != nil {
+				current.Content = content
+				lines = append(lines, current)
+				current = nil
+			}
+			continue
+		}
+		if current == nil {
+			// <sha> <original line> <final line> [<lines of the group>]
+			fields := strings.Fields(row)
+			if len(fields) < 3 {
+				continue
+			}
+			c := commits[fields[0]]
+			if c == nil {
+				c = &Commit{Hash: fields[0]}
+				commits[fields[0]] = c
+			}
+			number, _ := strconv.Atoi(fields[2])
+			current = &BlameLine{Number: number, Commit: c}
+			continue
+		}
+
+		key, value, _ := strings.Cut(row, " ")
+		c := current.Commit
+		switch key {
+		case "author":
+			c.Author = value
+		case "author-mail":
+			c.Email = strings.Trim(value, "<>")
+		case "author-time":
+			sec, _ := strconv.ParseInt(value, 10, 64)
+			c.Date = time.Unix(sec, 0)
+		case "summary":
+			c.Subject = value
+			c.Emoji, c.Type, c.Types = classify(value)
+			if strings.Trim(c.Hash, "0") == "" {
+				c.Emoji, c.Type, c.Types = "", uncommitted, nil
+			}
+		}
+	}
+	return lines
+}
+
+// printBlameSummary prints the share of the lines of each type, in the order
+// of emoji.config:
+//
+//	💻 Features       62.5%  (125 lines)
+//	🚧 Bug Fixes      30.0%  (60 lines)
+//	   Uncategorized   7.5%  (15 lines)
+func printBlameSummary(lines []*BlameLine) {
+	if len(lines) == 0 {
+		return
+	}
+	counts := make(map[*Type]int)
+	for _, line := range lines {
+		typ := line.Commit.Type
+		if typ == nil {
+			typ = uncategorized
+		}
+		counts[typ]++
+	}
+	nameWidth := 0
+	for typ := range counts {
+		nameWidth = max(nameWidth, len(typ.Name))
+	}
+	for _, typ := range append(allTypes, uncategorized, uncommitted) {
+		count := counts[typ]
+		if count == 0 {
+			continue
+		}
+		icon := "  "
+		if len(typ.Icons) > 0 {
+			icon = typ.Icons[0]
+		}
+		unit := "lines"
+		if count == 1 {
+			unit = "line"
+		}
+		fmt.Printf("%s %-*s %5.1f%%  (%d %s)\n", icon, nameWidth, typ.Name,
+			100*float64(count)/float64(len(lines)), count, unit)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// breakingType returns the first type of the emojis flagged "breaking = true"
+func breakingType(emojis string) *Type {
+	for _, typ := range subjectTypes(emojis) {
+		if typ.Breaking {
+			return typ
+		}
+	}
+	return nil
+}
+
+// confirmBreaking asks to confirm the breaking change when one of the emojis
+// has a breaking type. There is nothing to confirm without tty.
+func confirmBreaking(emojis string) {
+	typ := breakingType(emojis)
+	if typ == nil || !isTtyAvailable() {
+		return
+	}
+	fmt.Printf("💥 %v break the compatibility for the consumers. Continue? [y/N]: ", typ.Name)
+	switch strings.ToLower(strings.TrimSpace(readLine())) {
+	case "y", "yes":
+	default:
+		fatalf("aborted, choose another type if it is not a breaking change")
+	}
+}
+
+// askMigrationNote asks for the migration note of a breaking change when the
+// message has no body, and adds it after the subject of the message file
+func askMigrationNote(msgFile string) {
+	msg := string(must(os.ReadFile(msgFile)))
+	firstLine, _ := validateMsgFile(msg)
+	typ := breakingType(firstLine)
+	if typ == nil || hasBody(msg) {
+		return
+	}
+	var note string
+	for note == "" {
+		fmt.Printf("👉 %v require a migration note, how do the consumers upgrade?\n   ", typ.Name)
+		note = strings.TrimSpace(readLine())
+	}
+
+	lines := strings.Split(msg, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue // before the subject
+		}
+		rest := lines[i+1:]
+		if len(rest) == 0 || rest[0] != "" {
+			rest = append([]string{""}, rest...)
+		}
+		lines = append(lines[:i+1:i+1], append([]string{"", note}, rest...)...)
+		break
+	}
+	must(0, os.WriteFile(msgFile, []byte(strings.Join(lines, "\n")), 0644))
+}
+
+// checkMigrationNote fails when a breaking change has no body
+func checkMigrationNote(msg string) {
+	if _, ok := cutAutosquashPrefix(strings.TrimSpace(msg)); ok {
+		return // checked on the commit it is squashed into
+	}
+	firstLine, _ := validateMsgFile(msg)
+	if typ := breakingType(firstLine); typ != nil && !hasBody(msg) {
+		fatalf("%v commits require a migration note in the body, explaining how the consumers upgrade", typ.Name)
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Changelog is the data of the changelog templates
+type Changelog struct {
+	Releases []*Release `json:"releases"` // newest first
+	Types    []*Type    `json:"types"`    // the types of emoji.config, in order
+}
+
+// Release is the commits of a tag, or the unreleased commits
+type Release struct {
+	Version  string       `json:"version"`  // the tag, empty for the unreleased commits
+	Previous string       `json:"previous"` // the previous tag, empty for the first release
+	Date     time.Time    `json:"date"`     // the date of the tagged commit, or of the latest commit
+	Groups   []*TypeGroup `json:"groups"`   // the commits grouped by type, in the order of emoji.config
+	Commits  []*Commit    `json:"-"`        // newest first
+}
+
+// MarshalJSON writes the fields of the type used by the changelog
+func (t *Type) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name  string   `json:"name"`
+		Alias []string `json:"alias"`
+		Icons []string `json:"icons"`
+	}{t.Name, t.Alias, t.Icons})
+}
+
+// built-in changelog templates, selected with --format. The "release"
+// template renders one release, for --update.
+var changelogTemplates = map[string]string{
+	"md": `{{define "release"}}## {{if .Version}}{{.Version}} - {{date .Date}}{{else}}Unreleased{{end}}
+{{range .Groups}}
+### {{.Type.Name}}
+
+{{range .Commits}}- {{.Subject}} ({{.ShortHash}})
+{{end}}{{end}}{{end}}# Changelog
+{{range .Releases}}
+{{template "release" .}}{{end}}`,
+
+	"html": `{{define "release"}}<h2>{{if .Version}}{{html .Version}} <small>{{date .Date}}</small>{{else}}Unreleased{{end}}</h2>
+{{range .Groups}}<h3>{{html .Type.Name}}</h3>
+<ul>
+{{range .Commits}}  <li>{{html .Subject}} <code>{{.ShortHash}}</code></li>
+{{end}}</ul>
+{{end}}{{end}}<h1>Changelog</h1>
+{{range .Releases}}
+{{template "release" .}}{{end}}`,
+
+	"json": `{{json .}}
+`,
+}
+
+// marks around each release written by "changelog --update"
+const (
+	changelogStartMark  = "<!-- START GIT.EMOJI %s -->"
+	changelogEndMark    = "<!-- END GIT.EMOJI -->"
+	changelogUnreleased = "unreleased"
+)
+
+var changelogFuncs = template.FuncMap{
+	"date": func(t time.Time) string { return t.Format("2006-01-02") },
+	"json": func(v any) (string, error) {
+		data, err := json.MarshalIndent(v, "", "  ")
+		return string(data), err
+	},
+	"text":  (*Commit).subjectWithoutEmoji,
+	"join":  strings.Join,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+// execChangelog prints the changelog with a built-in or a custom template:
+//
+//	git.emoji changelog [--since <tag>] [--format md|html|json] [--template <file>]
+//	git.emoji changelog --update <file> [--format md|html] [--template <file>]
+func execChangelog(args []string) {
+	opts, positional := parseCmdArgs(args, "--since", "--format", "--template", "--update")
+	checkCmdOptions("changelog", opts, "--since", "--format", "--template", "--update")
+	if len(positional) != 0 {
+		fatalf("usage: git.emoji changelog [--since <tag>] [--format md|html|json] [--template <file>]")
+	}
+	tmpl := parseChangelogTemplate(opts["--format"], opts["--template"])
+	if file := opts["--update"]; file != "" {
+		if opts["--since"] != "" {
+			fatalf("--since and --update can not be used together, the file has the latest version")
+		}
+		updateChangelog(file, tmpl)
+		return
+	}
+	data := &Changelog{Releases: collectReleases(opts["--since"]), Types: allTypes}
+	if err := tmpl.Execute(os.Stdout, data); err != nil {
+		fatalf("rendering changelog: %v", err)
+	}
+}
+
+// updateChangelog inserts the releases newer than the latest version of the
+// file at the top, each one between the changelog marks. The unreleased block
+// of the previous update is replaced, the rest of the file is kept as is.
+func updateChangelog(file string, tmpl *template.Template) {
+	release := tmpl.Lookup("release")
+	if release == nil {
+		fatalf("--update requires the md or html format, or a template defining a \"release\" template")
+	}
+	data, err := os.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		fatalf("reading %v: %v", file, err)
+	}
+	lines, at, latest := parseChangelogFile(string(data))
+	if latest != "" {
+		if _, _, err := execGitx("merge-base", "--is-ancestor", latest, "HEAD"); err != nil {
+			fatalf("%v of %v is not reachable from HEAD", latest, file)
+		}
+	}
+
+	var blocks []string
+	for _, r := range collectReleases(latest) {
+		var b bytes.Buffer
+		if err := release.Execute(&b, r); err != nil {
+			fatalf("rendering changelog: %v", err)
+		}
+		version := r.Version
+		if version == "" {
+			version = changelogUnreleased
+		}
+		blocks = append(blocks, fmt.Sprintf(changelogStartMark, version)+"\n"+
+			strings.TrimSpace(b.String())+"\n"+changelogEndMark+"\n")
+	}
+	if len(lines) == 0 {
+		lines, at = []string{"# Changelog", ""}, 2
+	}
+
+	out := slices.Clone(lines[:at])
+	out = append(out, blocks...)
+	out = append(out, lines[at:]...)
+	content := strings.TrimRight(strings.Join(out, "\n"), "\n") + "\n"
+	if content == string(data) {
+		infof("✅ %v is up to date", file)
+		return
+	}
+	perm := os.FileMode(0644)
+	if st, err := os.Stat(file); err == nil {
+		perm = st.Mode().Perm()
+	}
+	if err := writeFileAtomic(file, []byte(content), perm); err != nil {
+		fatalf("writing %v: %v", file, err)
+	}
+	infof("✅ updated %v with %d releases", file, len(blocks))
+}
+
+// parseChangelogFile returns the lines of the changelog without the
+// unreleased block, the line to insert the new releases at, and the latest
+// version: the first release between the changelog marks, or the first
+// heading naming a tag for a changelog written by hand. The new releases go
+// before it, else after the title.
+func parseChangelogFile(dataStr string) (lines []string, at int, latest string) {
+	if strings.TrimSpace(dataStr) == "" {
+		return nil, 0, ""
+	}
+	tags := map[string]bool{}
+	if out, _, err := execGitx("tag", "--list"); err == nil {
+		for _, tag := range strings.Fields(out) {
+			if _, ok := parseSemver(tag); ok {
+				tags[tag] = true
+			}
+		}
+	}
+	at = -1
+	inUnreleased, afterUnreleased := false, false
+	for _, line := range strings.Split(strings.TrimRight(dataStr, "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if inUnreleased {
+			inUnreleased = trimmed != changelogEndMark
+			afterUnreleased = !inUnreleased
+			continue
+		}
+		if afterUnreleased && trimmed == "" {
+			continue // the blank line after the block
+		}
+		afterUnreleased = false
+		var version string
+		if _, err := fmt.Sscanf(trimmed, changelogStartMark, &version); err == nil {
+			if version == changelogUnreleased {
+				inUnreleased = true
+				continue
+			}
+		} else if strings.HasPrefix(trimmed, "#") && at < 0 {
+			for _, field := range strings.Fields(trimmed) {
+				if field = strings.Trim(field, "#[]()*`"); tags[field] {
+					version = field
+					break
+				}
+			}
+		}
+		if version != "" && at < 0 {
+			at, latest = len(lines), version
+		}
+		lines = append(lines, line)
+	}
+	if at < 0 {
+		// after the title and its blank line
+		at = 0
+		if len(lines) > 0 && strings.HasPrefix(lines[0], "# ") {
+			at = 1
+			for at < len(lines) && strings.TrimSpace(lines[at]) == "" {
+				at++
+			}
+		}
+	}
+	return lines, at, latest
+}
+
+// parseChangelogTemplate parses the template file, or the built-in template
+// of the format, markdown by default
+func parseChangelogTemplate(format, file string) *template.Template {
+	var text string
+	switch {
+	case file != "":
+		if format != "" {
+			fatalf("--format and --template can not be used together")
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			fatalf("reading template: %v", err)
+		}
+		text = string(data)
+	case format == "":
+		text = changelogTemplates["md"]
+	default:
+		var ok bool
+		if text, ok = changelogTemplates[format]; !ok {
+			fatalf("unknown changelog format %q (expected md, html or json)", format)
+		}
+	}
+	tmpl, err := template.New("changelog").Funcs(changelogFuncs).Parse(text)
+	if err != nil {
+		fatalf("parsing template: %v", err)
+	}
+	return tmpl
+}
+
+// collectReleases returns the unreleased commits and the releases of the
+// semver tags reachable from HEAD, newest first, stopping at the since tag
+func collectReleases(since string) (releases []*Release) {
+	ref, version := "HEAD", ""
+	for {
+		prev := lastTag(ref)
+		if version != "" {
+			prev = lastTag(version + "^")
+		}
+		commits := gitLog("--no-merges", revRange(prev, ref))
+		if version != "" || len(commits) > 0 {
+			releases = append(releases, newRelease(version, prev, commits))
+		}
+		if prev == "" || prev == since {
+			return releases
+		}
+		ref, version = prev, prev
+	}
+}
+
+func newRelease(version, prev string, commits []*Commit) *Release {
+	r := &Release{Version: version, Previous: prev, Commits: commits, Groups: groupByType(commits)}
+	if version != "" {
+		date, _, _ := execGitx("log", "-1", "--format=%cI", version)
+		r.Date, _ = time.Parse(time.RFC3339, date)
+	} else if len(commits) > 0 {
+		r.Date = commits[0].Date
+	}
+	return r
+}
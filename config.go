@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -13,10 +14,72 @@ type Type struct {
 	Name  string
 	Alias []string
 	Icons []string
+	Paths []string // staged paths that imply this type
+
+	Branches []string // branch name patterns that imply this type
+
+	Bump bumpLevel // semantic version bump of the commits of this type
+
+	Trailers    []string // trailer keys required on the commits of this type
+	RequireBody bool     // the commits of this type must have a body
+	Breaking    bool     // confirm the commits of this type and require a migration note
 }
 
+// Settings are the options in the [git.emoji] section of emoji.config.
+type Settings struct {
+	NonInteractive     []string // policies to choose the emoji when no tty is available
+	NonInteractiveSkip []string // COMMIT_SOURCE values to leave untouched when no tty is available
+	DefaultType        string   // type used by the "default" policy
+	BranchMode         string   // how to use the type inferred from the branch name
+
+	Template      string         // subject template: {emoji} {type} {scope} {ticket} {subject}
+	TicketPattern *regexp.Regexp // extract {ticket} from the branch name
+	ScopePattern  *regexp.Regexp // extract {scope} from the branch name
+
+	EmojiPosition string // where the emoji is in the subject
+	Shortcodes    bool   // write :shortcodes: instead of emojis
+
+	Team map[string]string // --pair name to "Name <email>", from [git.emoji.team]
+
+	LintMaxSubject  int      // max display width of the subject, 0 to disable
+	LintNoPeriod    bool     // the subject must not end with a period
+	LintImperative  bool     // the subject must start with an imperative verb
+	LintBlankLine   bool     // a blank line must separate the subject and the body
+	LintBodyWrap    int      // max display width of the body lines, 0 to disable
+	LintBannedWords []string // words not allowed in the message: wip fixup
+}
+
+// policies to choose the emoji when no tty is available, tried in order
+const (
+	policyBranch  = "branch"  // infer from the branch name (feat/...)
+	policyPaths   = "paths"   // infer from the staged paths
+	policyDefault = "default" // use default-type
+	policyFail    = "fail"    // leave the message untouched, commit-msg will reject it
+)
+
+var allPolicies = []string{policyBranch, policyPaths, policyDefault, policyFail}
+
+// how to use the type inferred from the branch name
+const (
+	branchModePreselect = "preselect" // pre-select it in the prompt
+	branchModeAuto      = "auto"      // apply it without asking
+	branchModeOff       = "off"       // always ask
+)
+
+var allBranchModes = []string{branchModePreselect, branchModeAuto, branchModeOff}
+
+// where the emoji is in the subject
+const (
+	positionStart  = "start"  // 💻 subject
+	positionTicket = "ticket" // PROJ-123: 💻 subject
+	positionScope  = "scope"  // [scope] 💻 subject
+	positionEnd    = "end"    // subject 💻
+)
+
+var allPositions = []string{positionStart, positionTicket, positionScope, positionEnd}
+
 func newType(name string) *Type {
-	return &Type{Name: name}
+	return &Type{Name: name, Bump: bumpPatch}
 }
 func getType(name string) *Type {
 	for _, typ := range allTypes {
@@ -27,21 +90,149 @@ func getType(name string) *Type {
 	panic("unknown type: " + name)
 }
 
-func (t *Type) icon(icons ...string) *Type { t.Icons = append(t.Icons, icons...); return t }
-func (t *Type) alias(as ...string) *Type   { t.Alias = append(t.Alias, as...); return t }
+func (t *Type) icon(icons ...string) *Type  { t.Icons = append(t.Icons, icons...); return t }
+func (t *Type) alias(as ...string) *Type    { t.Alias = append(t.Alias, as...); return t }
+func (t *Type) paths(ps ...string) *Type    { t.Paths = append(t.Paths, ps...); return t }
+func (t *Type) bump(b bumpLevel) *Type      { t.Bump = b; return t }
+func (t *Type) branches(bs ...string) *Type { t.Branches = append(t.Branches, bs...); return t }
+func (t *Type) breaking() *Type             { t.Breaking = true; return t }
+
+// lookupType finds a type by alias or by name (case-insensitive)
+func lookupType(s string) *Type {
+	if typ, ok := mapTypes[s]; ok {
+		return typ
+	}
+	for _, typ := range allTypes {
+		if strings.EqualFold(typ.Name, s) {
+			return typ
+		}
+	}
+	return nil
+}
+
+// typeOfIcon returns the type of the icon, or a type with only this icon
+func typeOfIcon(icon string) (*Type, int) {
+	if typ := mapIcons[normalizeEmoji(icon)]; typ != nil {
+		for i, x := range typ.Icons {
+			if normalizeEmoji(x) == normalizeEmoji(icon) {
+				return typ, i
+			}
+		}
+	}
+	return &Type{Icons: []string{icon}}, 0
+}
+
+func defaultSettings() Settings {
+	return Settings{
+		NonInteractive: []string{policyBranch, policyPaths, policyDefault},
+		DefaultType:    "other",
+		BranchMode:     branchModePreselect,
+		Template:       "{emoji} {subject}",
+		TicketPattern:  regexp.MustCompile(`[A-Z][A-Z0-9]+-[0-9]+`),
+		EmojiPosition:  positionStart,
+	}
+}
+
+func (s *Settings) set(key string, value string) error {
+	switch key {
+	case "noninteractive":
+		values := splitList(value)
+		for _, v := range values {
+			if !slices.Contains(allPolicies, v) {
+				return fmt.Errorf("unknown noninteractive policy %q (expected one of %v)", v, strings.Join(allPolicies, ", "))
+			}
+		}
+		s.NonInteractive = values
+	case "noninteractive-skip":
+		s.NonInteractiveSkip = splitList(value)
+	case "default-type":
+		s.DefaultType = value
+	case "branch-mode":
+		if !slices.Contains(allBranchModes, value) {
+			return fmt.Errorf("unknown branch-mode %q (expected one of %v)", value, strings.Join(allBranchModes, ", "))
+		}
+		s.BranchMode = value
+	case "template":
+		s.Template = value
+	case "shortcodes":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid shortcodes %q (expected true or false)", value)
+		}
+		s.Shortcodes = b
+	case "lint-max-subject", "lint-body-wrap":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid %s %q (expected a number of columns, 0 to disable)", key, value)
+		}
+		if key == "lint-max-subject" {
+			s.LintMaxSubject = n
+		} else {
+			s.LintBodyWrap = n
+		}
+	case "lint-no-period", "lint-imperative", "lint-blank-line":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q (expected true or false)", key, value)
+		}
+		switch key {
+		case "lint-no-period":
+			s.LintNoPeriod = b
+		case "lint-imperative":
+			s.LintImperative = b
+		default:
+			s.LintBlankLine = b
+		}
+	case "lint-banned-words":
+		s.LintBannedWords = splitList(value)
+	case "emoji-position":
+		if !slices.Contains(allPositions, value) {
+			return fmt.Errorf("unknown emoji-position %q (expected one of %v)", value, strings.Join(allPositions, ", "))
+		}
+		s.EmojiPosition = value
+	case "ticket-pattern", "scope-pattern":
+		var re *regexp.Regexp
+		if value != "" {
+			var err error
+			if re, err = regexp.Compile(value); err != nil {
+				return fmt.Errorf("invalid %s: %w", key, err)
+			}
+		}
+		if key == "ticket-pattern" {
+			s.TicketPattern = re
+		} else {
+			s.ScopePattern = re
+		}
+	default:
+		return fmt.Errorf("unknown setting: %s", key)
+	}
+	return nil
+}
 
 func defaultConfig() []*Type {
 	return []*Type{
-		newType("Features").icon("💻", "✨").alias("feat", "ft"),
-		newType("Bug Fixes").icon("🚧", "🐛").alias("fix", "fx"),
+		newType("Features").icon("💻", "✨").alias("feat", "ft").
+			branches("feat/*", "feature/*").bump(bumpMinor),
+		newType("Bug Fixes").icon("🚧", "🐛").alias("fix", "fx").
+			branches("fix/*", "bugfix/*", "hotfix/*"),
 		newType("SDKs/Libraries").icon("🛠️", "📦").alias("sdk", "lib", "pkg", "tenets"),
-		newType("Breaking Changes").icon("🔥", "💥").alias("breaking", "br", "brk", "break"),
-		newType("Code Refactoring").icon("♻️").alias("refactor", "rf", "ref", "rft"),
-		newType("Infrastructure").icon("🐳").alias("infra", "if", "in", "inf"),
-		newType("Tests").icon("🚨", "🧪").alias("test", "ts", "tst"),
-		newType("Chores").icon("🧼", "🧹").alias("chore", "ch", "chr"),
-		newType("Reverts").icon("⏳", "⏪").alias("revert", "rv", "rev", "rvt"),
-		newType("Releases").icon("🚀", "🔖").alias("release", "rl", "rel", "rls"),
+		newType("Breaking Changes").icon("🔥", "💥").alias("breaking", "br", "brk", "break").
+			branches("breaking/*").bump(bumpMajor).breaking(),
+		newType("Code Refactoring").icon("♻️").alias("refactor", "rf", "ref", "rft").
+			branches("refactor/*"),
+		newType("Infrastructure").icon("🐳").alias("infra", "if", "in", "inf").
+			paths("Dockerfile*", "docker-compose*.yml", ".github/", ".gitlab-ci.yml", "*.tf").
+			branches("infra/*", "ci/*"),
+		newType("Tests").icon("🚨", "🧪").alias("test", "ts", "tst").
+			paths("*_test.go", "*.test.*", "*.spec.*", "test/", "tests/", "__tests__/").
+			branches("test/*", "tests/*"),
+		newType("Chores").icon("🧼", "🧹").alias("chore", "ch", "chr").
+			branches("chore/*"),
+		newType("Reverts").icon("⏳", "⏪").alias("revert", "rv", "rev", "rvt").
+			branches("revert/*"),
+		newType("Releases").icon("🚀", "🔖").alias("release", "rl", "rel", "rls").
+			branches("release/*").bump(bumpNone),
+		newType("Merges").icon("🔀").alias("merge", "mg").bump(bumpNone),
 		newType("Others").icon("🔍").alias("other", "ot", "oth"),
 	}
 }
@@ -71,7 +262,7 @@ func loadConfig() {
 		if err != nil {
 			fatalf("failed to read config file %s: %s", current, err)
 		}
-		allTypes, err = parseConfig(data)
+		allTypes, settings, err = parseConfig(data)
 		if err != nil {
 			fatalf("failed to parse config file %s: %s", current, err)
 		}
@@ -80,13 +271,17 @@ func loadConfig() {
 		}
 	} else {
 		debugf("no config file found")
-		allTypes = defaultConfig()
+		allTypes, settings = defaultConfig(), defaultSettings()
 	}
 	mapTypes = make(map[string]*Type)
+	mapIcons = make(map[string]*Type)
 	for _, typ := range allTypes {
 		for _, alias := range typ.Alias {
 			mapTypes[alias] = typ
 		}
+		for _, icon := range typ.Icons {
+			mapIcons[normalizeEmoji(icon)] = typ
+		}
 	}
 }
 
@@ -109,14 +304,37 @@ func writeConfigFile(config []*Type) {
 			continue
 		}
 		file := defaultConfigFiles()[id-1]
-		must(0, os.WriteFile(file, marshalConfigFile(config), 0644))
+		must(0, os.WriteFile(file, marshalConfigFile(settings, config), 0644))
 		infof("✅ Successfully write config file to %s", file)
 		return
 	}
 }
 
-func marshalConfigFile(config []*Type) []byte {
+func patternString(re *regexp.Regexp) string {
+	if re == nil {
+		return ""
+	}
+	return re.String()
+}
+
+func marshalConfigFile(set Settings, config []*Type) []byte {
 	var buf bytes.Buffer
+	buf.WriteString("[git.emoji]\n")
+	buf.WriteString("    noninteractive = " + strings.Join(set.NonInteractive, " ") + "\n")
+	buf.WriteString("    noninteractive-skip = " + strings.Join(set.NonInteractiveSkip, " ") + "\n")
+	buf.WriteString("    default-type = " + set.DefaultType + "\n")
+	buf.WriteString("    branch-mode = " + set.BranchMode + "\n")
+	buf.WriteString("    template = " + set.Template + "\n")
+	buf.WriteString("    emoji-position = " + set.EmojiPosition + "\n")
+	buf.WriteString("    shortcodes = " + strconv.FormatBool(set.Shortcodes) + "\n")
+	buf.WriteString("    ticket-pattern = " + patternString(set.TicketPattern) + "\n")
+	buf.WriteString("    scope-pattern = " + patternString(set.ScopePattern) + "\n")
+	buf.WriteString("    lint-max-subject = " + strconv.Itoa(set.LintMaxSubject) + "\n")
+	buf.WriteString("    lint-no-period = " + strconv.FormatBool(set.LintNoPeriod) + "\n")
+	buf.WriteString("    lint-imperative = " + strconv.FormatBool(set.LintImperative) + "\n")
+	buf.WriteString("    lint-blank-line = " + strconv.FormatBool(set.LintBlankLine) + "\n")
+	buf.WriteString("    lint-body-wrap = " + strconv.Itoa(set.LintBodyWrap) + "\n")
+	buf.WriteString("    lint-banned-words = " + strings.Join(set.LintBannedWords, " ") + "\n")
 	for _, typ := range config {
 		buf.WriteString(fmt.Sprintf("[git.emoji %q]\n", typ.Name))
 		buf.WriteString("    icons = ")
@@ -125,13 +343,64 @@ func marshalConfigFile(config []*Type) []byte {
 		buf.WriteString("    alias = ")
 		buf.WriteString(strings.Join(typ.Alias, " "))
 		buf.WriteString("\n")
+		if len(typ.Paths) > 0 {
+			buf.WriteString("    paths = ")
+			buf.WriteString(strings.Join(typ.Paths, " "))
+			buf.WriteString("\n")
+		}
+		if len(typ.Branches) > 0 {
+			buf.WriteString("    branches = ")
+			buf.WriteString(strings.Join(typ.Branches, " "))
+			buf.WriteString("\n")
+		}
+		if typ.Bump != bumpPatch {
+			buf.WriteString("    bump = " + typ.Bump.String() + "\n")
+		}
+		if len(typ.Trailers) > 0 {
+			buf.WriteString("    trailers = " + strings.Join(typ.Trailers, " ") + "\n")
+		}
+		if typ.RequireBody {
+			buf.WriteString("    require-body = true\n")
+		}
+		if typ.Breaking {
+			buf.WriteString("    breaking = true\n")
+		}
+	}
+	if len(set.Team) > 0 {
+		buf.WriteString("[git.emoji.team]\n")
+		var names []string
+		for name := range set.Team {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			buf.WriteString("    " + name + " = " + set.Team[name] + "\n")
+		}
 	}
 	return buf.Bytes()
 }
 
+// Full Name <email>
+var reIdent = regexp.MustCompile(`^[^<>]+ <[^<>\s]+>$`)
+
+var reSpaceOrComma = regexp.MustCompile(`[ ,]`)
+
+func splitList(s string) (out []string) {
+	for _, part := range reSpaceOrComma.Split(s, -1) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		out = append(out, part)
+	}
+	return
+}
+
 // parse emoji.config
-func parseConfig(data []byte) (out []*Type, outErr error) {
+func parseConfig(data []byte) (out []*Type, set Settings, outErr error) {
+	set = defaultSettings()
 	var section *Type
+	inSettings, inTeam := false, false
 	closeSection := func() {
 		if section == nil {
 			return
@@ -147,17 +416,6 @@ func parseConfig(data []byte) (out []*Type, outErr error) {
 		out = append(out, section)
 		section = nil
 	}
-	reSpaceOrComma := regexp.MustCompile(`[ ,]`)
-	splitSpace := func(s string) (out []string) {
-		for _, part := range reSpaceOrComma.Split(s, -1) {
-			part = strings.TrimSpace(part)
-			if part == "" {
-				continue
-			}
-			out = append(out, part)
-		}
-		return
-	}
 
 	for _, line := range strings.Split(string(data), "\n") {
 		line = strings.TrimSpace(line)
@@ -170,9 +428,14 @@ func parseConfig(data []byte) (out []*Type, outErr error) {
 
 		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
 			closeSection()
+			if outErr != nil {
+				return
+			}
 
 			xline := strings.TrimSpace(line[1 : len(line)-1])
-			if !strings.HasPrefix(xline, "git.emoji") {
+			inSettings = xline == "git.emoji"
+			inTeam = xline == "git.emoji.team"
+			if inSettings || inTeam || !strings.HasPrefix(xline, "git.emoji") {
 				section = nil
 				continue
 			}
@@ -180,11 +443,34 @@ func parseConfig(data []byte) (out []*Type, outErr error) {
 			quotedName := strings.TrimSpace(xline[len("git.emoji"):])
 			name, err := strconv.Unquote(quotedName)
 			if err != nil {
-				return nil, fmt.Errorf("failed to parse section: %s", line)
+				return nil, set, fmt.Errorf("failed to parse section: %s", line)
 			}
-			section = &Type{Name: name}
+			section = newType(name)
 			continue
 
+		case inSettings:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				outErr = fmt.Errorf("failed to parse line (section git.emoji): %s", line)
+				return
+			}
+			if err := set.set(strings.TrimSpace(key), strings.TrimSpace(value)); err != nil {
+				outErr = fmt.Errorf("section git.emoji: %w", err)
+				return
+			}
+
+		case inTeam:
+			name, ident, ok := strings.Cut(line, "=")
+			name, ident = strings.TrimSpace(name), strings.TrimSpace(ident)
+			if !ok || !reIdent.MatchString(ident) {
+				outErr = fmt.Errorf("failed to parse line (section git.emoji.team, expected name = Full Name <email>): %s", line)
+				return
+			}
+			if set.Team == nil {
+				set.Team = make(map[string]string)
+			}
+			set.Team[name] = ident
+
 		default:
 			if section == nil {
 				continue // skip line if not in a section
@@ -197,9 +483,46 @@ func parseConfig(data []byte) (out []*Type, outErr error) {
 			directive := strings.TrimSpace(parts[0])
 			switch directive {
 			case "icons":
-				section.Icons = append(section.Icons, splitSpace(parts[1])...)
+				for _, icon := range splitList(parts[1]) {
+					if strings.HasPrefix(icon, ":") {
+						emoji, ok := shortcodeToEmoji(icon)
+						if !ok {
+							outErr = fmt.Errorf("unknown shortcode (section %q): %s", section.Name, icon)
+							return
+						}
+						icon = emoji
+					}
+					section.Icons = append(section.Icons, icon)
+				}
 			case "alias":
-				section.Alias = append(section.Alias, splitSpace(parts[1])...)
+				section.Alias = append(section.Alias, splitList(parts[1])...)
+			case "paths":
+				section.Paths = append(section.Paths, splitList(parts[1])...)
+			case "branches":
+				section.Branches = append(section.Branches, splitList(parts[1])...)
+			case "bump":
+				bump, err := parseBumpLevel(strings.TrimSpace(parts[1]))
+				if err != nil {
+					outErr = fmt.Errorf("section %q: %w", section.Name, err)
+					return
+				}
+				section.Bump = bump
+			case "trailers":
+				section.Trailers = append(section.Trailers, splitList(parts[1])...)
+			case "require-body":
+				b, err := strconv.ParseBool(strings.TrimSpace(parts[1]))
+				if err != nil {
+					outErr = fmt.Errorf("invalid require-body (section %q): %s", section.Name, parts[1])
+					return
+				}
+				section.RequireBody = b
+			case "breaking":
+				b, err := strconv.ParseBool(strings.TrimSpace(parts[1]))
+				if err != nil {
+					outErr = fmt.Errorf("invalid breaking (section %q): %s", section.Name, parts[1])
+					return
+				}
+				section.Breaking = b
 			default:
 				outErr = fmt.Errorf("unknown directive (section %q): %s", section.Name, directive)
 				return
@@ -207,5 +530,5 @@ func parseConfig(data []byte) (out []*Type, outErr error) {
 		}
 	}
 	closeSection()
-	return out, outErr
+	return out, set, outErr
 }
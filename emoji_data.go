@@ -0,0 +1,3792 @@
+// Code generated by gen_emoji.go from data/emoji-test.txt; DO NOT EDIT.
+
+package main
+
+// unicodeEmojiVersion is the version of data/emoji-test.txt
+const unicodeEmojiVersion = "15.1"
+
+// emojiTable lists the fully-qualified emojis and the components
+var emojiTable = []emojiInfo{
+	{"😀", "grinning face", "Smileys & Emotion", "1.0"},
+	{"😃", "grinning face with big eyes", "Smileys & Emotion", "0.6"},
+	{"😄", "grinning face with smiling eyes", "Smileys & Emotion", "0.6"},
+	{"😁", "beaming face with smiling eyes", "Smileys & Emotion", "0.6"},
+	{"😆", "grinning squinting face", "Smileys & Emotion", "0.6"},
+	{"😅", "grinning face with sweat", "Smileys & Emotion", "0.6"},
+	{"🤣", "rolling on the floor laughing", "Smileys & Emotion", "3.0"},
+	{"😂", "face with tears of joy", "Smileys & Emotion", "0.6"},
+	{"🙂", "slightly smiling face", "Smileys & Emotion", "1.0"},
+	{"🙃", "upside-down face", "Smileys & Emotion", "1.0"},
+	{"🫠", "melting face", "Smileys & Emotion", "14.0"},
+	{"😉", "winking face", "Smileys & Emotion", "0.6"},
+	{"😊", "smiling face with smiling eyes", "Smileys & Emotion", "0.6"},
+	{"😇", "smiling face with halo", "Smileys & Emotion", "1.0"},
+	{"🥰", "smiling face with hearts", "Smileys & Emotion", "11.0"},
+	{"😍", "smiling face with heart-eyes", "Smileys & Emotion", "0.6"},
+	{"🤩", "star-struck", "Smileys & Emotion", "5.0"},
+	{"😘", "face blowing a kiss", "Smileys & Emotion", "0.6"},
+	{"😗", "kissing face", "Smileys & Emotion", "1.0"},
+	{"☺️", "smiling face", "Smileys & Emotion", "0.6"},
+	{"😚", "kissing face with closed eyes", "Smileys & Emotion", "0.6"},
+	{"😙", "kissing face with smiling eyes", "Smileys & Emotion", "1.0"},
+	{"🥲", "smiling face with tear", "Smileys & Emotion", "13.0"},
+	{"😋", "face savoring food", "Smileys & Emotion", "0.6"},
+	{"😛", "face with tongue", "Smileys & Emotion", "1.0"},
+	{"😜", "winking face with tongue", "Smileys & Emotion", "0.6"},
+	{"🤪", "zany face", "Smileys & Emotion", "5.0"},
+	{"😝", "squinting face with tongue", "Smileys & Emotion", "0.6"},
+	{"🤑", "money-mouth face", "Smileys & Emotion", "1.0"},
+	{"🤗", "smiling face with open hands", "Smileys & Emotion", "1.0"},
+	{"🤭", "face with hand over mouth", "Smileys & Emotion", "5.0"},
+	{"🫢", "face with open eyes and hand over mouth", "Smileys & Emotion", "14.0"},
+	{"🫣", "face with peeking eye", "Smileys & Emotion", "14.0"},
+	{"🤫", "shushing face", "Smileys & Emotion", "5.0"},
+	{"🤔", "thinking face", "Smileys & Emotion", "1.0"},
+	{"🫡", "saluting face", "Smileys & Emotion", "14.0"},
+	{"🤐", "zipper-mouth face", "Smileys & Emotion", "1.0"},
+	{"🤨", "face with raised eyebrow", "Smileys & Emotion", "5.0"},
+	{"😐", "neutral face", "Smileys & Emotion", "0.7"},
+	{"😑", "expressionless face", "Smileys & Emotion", "1.0"},
+	{"😶", "face without mouth", "Smileys & Emotion", "1.0"},
+	{"🫥", "dotted line face", "Smileys & Emotion", "14.0"},
+	{"😶\u200d🌫️", "face in clouds", "Smileys & Emotion", "13.1"},
+	{"😏", "smirking face", "Smileys & Emotion", "0.6"},
+	{"😒", "unamused face", "Smileys & Emotion", "0.6"},
+	{"🙄", "face with rolling eyes", "Smileys & Emotion", "1.0"},
+	{"😬", "grimacing face", "Smileys & Emotion", "1.0"},
+	{"😮\u200d💨", "face exhaling", "Smileys & Emotion", "13.1"},
+	{"🤥", "lying face", "Smileys & Emotion", "3.0"},
+	{"🫨", "shaking face", "Smileys & Emotion", "15.0"},
+	{"🙂\u200d↔️", "head shaking horizontally", "Smileys & Emotion", "15.1"},
+	{"🙂\u200d↕️", "head shaking vertically", "Smileys & Emotion", "15.1"},
+	{"😌", "relieved face", "Smileys & Emotion", "0.6"},
+	{"😔", "pensive face", "Smileys & Emotion", "0.6"},
+	{"😪", "sleepy face", "Smileys & Emotion", "0.6"},
+	{"🤤", "drooling face", "Smileys & Emotion", "3.0"},
+	{"😴", "sleeping face", "Smileys & Emotion", "1.0"},
+	{"😷", "face with medical mask", "Smileys & Emotion", "0.6"},
+	{"🤒", "face with thermometer", "Smileys & Emotion", "1.0"},
+	{"🤕", "face with head-bandage", "Smileys & Emotion", "1.0"},
+	{"🤢", "nauseated face", "Smileys & Emotion", "3.0"},
+	{"🤮", "face vomiting", "Smileys & Emotion", "5.0"},
+	{"🤧", "sneezing face", "Smileys & Emotion", "3.0"},
+	{"🥵", "hot face", "Smileys & Emotion", "11.0"},
+	{"🥶", "cold face", "Smileys & Emotion", "11.0"},
+	{"🥴", "woozy face", "Smileys & Emotion", "11.0"},
+	{"😵", "face with crossed-out eyes", "Smileys & Emotion", "0.6"},
+	{"😵\u200d💫", "face with spiral eyes", "Smileys & Emotion", "13.1"},
+	{"🤯", "exploding head", "Smileys & Emotion", "5.0"},
+	{"🤠", "cowboy hat face", "Smileys & Emotion", "3.0"},
+	{"🥳", "partying face", "Smileys & Emotion", "11.0"},
+	{"🥸", "disguised face", "Smileys & Emotion", "13.0"},
+	{"😎", "smiling face with sunglasses", "Smileys & Emotion", "1.0"},
+	{"🤓", "nerd face", "Smileys & Emotion", "1.0"},
+	{"🧐", "face with monocle", "Smileys & Emotion", "5.0"},
+	{"😕", "confused face", "Smileys & Emotion", "1.0"},
+	{"🫤", "face with diagonal mouth", "Smileys & Emotion", "14.0"},
+	{"😟", "worried face", "Smileys & Emotion", "1.0"},
+	{"🙁", "slightly frowning face", "Smileys & Emotion", "1.0"},
+	{"☹️", "frowning face", "Smileys & Emotion", "0.7"},
+	{"😮", "face with open mouth", "Smileys & Emotion", "1.0"},
+	{"😯", "hushed face", "Smileys & Emotion", "1.0"},
+	{"😲", "astonished face", "Smileys & Emotion", "0.6"},
+	{"😳", "flushed face", "Smileys & Emotion", "0.6"},
+	{"🥺", "pleading face", "Smileys & Emotion", "11.0"},
+	{"🥹", "face holding back tears", "Smileys & Emotion", "14.0"},
+	{"😦", "frowning face with open mouth", "Smileys & Emotion", "1.0"},
+	{"😧", "anguished face", "Smileys & Emotion", "1.0"},
+	{"😨", "fearful face", "Smileys & Emotion", "0.6"},
+	{"😰", "anxious face with sweat", "Smileys & Emotion", "0.6"},
+	{"😥", "sad but relieved face", "Smileys & Emotion", "0.6"},
+	{"😢", "crying face", "Smileys & Emotion", "0.6"},
+	{"😭", "loudly crying face", "Smileys & Emotion", "0.6"},
+	{"😱", "face screaming in fear", "Smileys & Emotion", "0.6"},
+	{"😖", "confounded face", "Smileys & Emotion", "0.6"},
+	{"😣", "persevering face", "Smileys & Emotion", "0.6"},
+	{"😞", "disappointed face", "Smileys & Emotion", "0.6"},
+	{"😓", "downcast face with sweat", "Smileys & Emotion", "0.6"},
+	{"😩", "weary face", "Smileys & Emotion", "0.6"},
+	{"😫", "tired face", "Smileys & Emotion", "0.6"},
+	{"🥱", "yawning face", "Smileys & Emotion", "12.0"},
+	{"😤", "face with steam from nose", "Smileys & Emotion", "0.6"},
+	{"😡", "enraged face", "Smileys & Emotion", "0.6"},
+	{"😠", "angry face", "Smileys & Emotion", "0.6"},
+	{"🤬", "face with symbols on mouth", "Smileys & Emotion", "5.0"},
+	{"😈", "smiling face with horns", "Smileys & Emotion", "1.0"},
+	{"👿", "angry face with horns", "Smileys & Emotion", "0.6"},
+	{"💀", "skull", "Smileys & Emotion", "0.6"},
+	{"☠️", "skull and crossbones", "Smileys & Emotion", "1.0"},
+	{"💩", "pile of poo", "Smileys & Emotion", "0.6"},
+	{"🤡", "clown face", "Smileys & Emotion", "3.0"},
+	{"👹", "ogre", "Smileys & Emotion", "0.6"},
+	{"👺", "goblin", "Smileys & Emotion", "0.6"},
+	{"👻", "ghost", "Smileys & Emotion", "0.6"},
+	{"👽", "alien", "Smileys & Emotion", "0.6"},
+	{"👾", "alien monster", "Smileys & Emotion", "0.6"},
+	{"🤖", "robot", "Smileys & Emotion", "1.0"},
+	{"😺", "grinning cat", "Smileys & Emotion", "0.6"},
+	{"😸", "grinning cat with smiling eyes", "Smileys & Emotion", "0.6"},
+	{"😹", "cat with tears of joy", "Smileys & Emotion", "0.6"},
+	{"😻", "smiling cat with heart-eyes", "Smileys & Emotion", "0.6"},
+	{"😼", "cat with wry smile", "Smileys & Emotion", "0.6"},
+	{"😽", "kissing cat", "Smileys & Emotion", "0.6"},
+	{"🙀", "weary cat", "Smileys & Emotion", "0.6"},
+	{"😿", "crying cat", "Smileys & Emotion", "0.6"},
+	{"😾", "pouting cat", "Smileys & Emotion", "0.6"},
+	{"🙈", "see-no-evil monkey", "Smileys & Emotion", "0.6"},
+	{"🙉", "hear-no-evil monkey", "Smileys & Emotion", "0.6"},
+	{"🙊", "speak-no-evil monkey", "Smileys & Emotion", "0.6"},
+	{"💌", "love letter", "Smileys & Emotion", "0.6"},
+	{"💘", "heart with arrow", "Smileys & Emotion", "0.6"},
+	{"💝", "heart with ribbon", "Smileys & Emotion", "0.6"},
+	{"💖", "sparkling heart", "Smileys & Emotion", "0.6"},
+	{"💗", "growing heart", "Smileys & Emotion", "0.6"},
+	{"💓", "beating heart", "Smileys & Emotion", "0.6"},
+	{"💞", "revolving hearts", "Smileys & Emotion", "0.6"},
+	{"💕", "two hearts", "Smileys & Emotion", "0.6"},
+	{"💟", "heart decoration", "Smileys & Emotion", "0.6"},
+	{"❣️", "heart exclamation", "Smileys & Emotion", "1.0"},
+	{"💔", "broken heart", "Smileys & Emotion", "0.6"},
+	{"❤️\u200d🔥", "heart on fire", "Smileys & Emotion", "13.1"},
+	{"❤️\u200d🩹", "mending heart", "Smileys & Emotion", "13.1"},
+	{"❤️", "red heart", "Smileys & Emotion", "0.6"},
+	{"🩷", "pink heart", "Smileys & Emotion", "15.0"},
+	{"🧡", "orange heart", "Smileys & Emotion", "5.0"},
+	{"💛", "yellow heart", "Smileys & Emotion", "0.6"},
+	{"💚", "green heart", "Smileys & Emotion", "0.6"},
+	{"💙", "blue heart", "Smileys & Emotion", "0.6"},
+	{"🩵", "light blue heart", "Smileys & Emotion", "15.0"},
+	{"💜", "purple heart", "Smileys & Emotion", "0.6"},
+	{"🤎", "brown heart", "Smileys & Emotion", "12.0"},
+	{"🖤", "black heart", "Smileys & Emotion", "3.0"},
+	{"🩶", "grey heart", "Smileys & Emotion", "15.0"},
+	{"🤍", "white heart", "Smileys & Emotion", "12.0"},
+	{"💋", "kiss mark", "Smileys & Emotion", "0.6"},
+	{"💯", "hundred points", "Smileys & Emotion", "0.6"},
+	{"💢", "anger symbol", "Smileys & Emotion", "0.6"},
+	{"💥", "collision", "Smileys & Emotion", "0.6"},
+	{"💫", "dizzy", "Smileys & Emotion", "0.6"},
+	{"💦", "sweat droplets", "Smileys & Emotion", "0.6"},
+	{"💨", "dashing away", "Smileys & Emotion", "0.6"},
+	{"🕳️", "hole", "Smileys & Emotion", "0.7"},
+	{"💬", "speech balloon", "Smileys & Emotion", "0.6"},
+	{"👁️\u200d🗨️", "eye in speech bubble", "Smileys & Emotion", "2.0"},
+	{"🗨️", "left speech bubble", "Smileys & Emotion", "2.0"},
+	{"🗯️", "right anger bubble", "Smileys & Emotion", "0.7"},
+	{"💭", "thought balloon", "Smileys & Emotion", "1.0"},
+	{"💤", "ZZZ", "Smileys & Emotion", "0.6"},
+	{"👋", "waving hand", "People & Body", "0.6"},
+	{"👋🏻", "waving hand: light skin tone", "People & Body", "1.0"},
+	{"👋🏼", "waving hand: medium-light skin tone", "People & Body", "1.0"},
+	{"👋🏽", "waving hand: medium skin tone", "People & Body", "1.0"},
+	{"👋🏾", "waving hand: medium-dark skin tone", "People & Body", "1.0"},
+	{"👋🏿", "waving hand: dark skin tone", "People & Body", "1.0"},
+	{"🤚", "raised back of hand", "People & Body", "3.0"},
+	{"🤚🏻", "raised back of hand: light skin tone", "People & Body", "3.0"},
+	{"🤚🏼", "raised back of hand: medium-light skin tone", "People & Body", "3.0"},
+	{"🤚🏽", "raised back of hand: medium skin tone", "People & Body", "3.0"},
+	{"🤚🏾", "raised back of hand: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤚🏿", "raised back of hand: dark skin tone", "People & Body", "3.0"},
+	{"🖐️", "hand with fingers splayed", "People & Body", "0.7"},
+	{"🖐🏻", "hand with fingers splayed: light skin tone", "People & Body", "1.0"},
+	{"🖐🏼", "hand with fingers splayed: medium-light skin tone", "People & Body", "1.0"},
+	{"🖐🏽", "hand with fingers splayed: medium skin tone", "People & Body", "1.0"},
+	{"🖐🏾", "hand with fingers splayed: medium-dark skin tone", "People & Body", "1.0"},
+	{"🖐🏿", "hand with fingers splayed: dark skin tone", "People & Body", "1.0"},
+	{"✋", "raised hand", "People & Body", "0.6"},
+	{"✋🏻", "raised hand: light skin tone", "People & Body", "1.0"},
+	{"✋🏼", "raised hand: medium-light skin tone", "People & Body", "1.0"},
+	{"✋🏽", "raised hand: medium skin tone", "People & Body", "1.0"},
+	{"✋🏾", "raised hand: medium-dark skin tone", "People & Body", "1.0"},
+	{"✋🏿", "raised hand: dark skin tone", "People & Body", "1.0"},
+	{"🖖", "vulcan salute", "People & Body", "1.0"},
+	{"🖖🏻", "vulcan salute: light skin tone", "People & Body", "1.0"},
+	{"🖖🏼", "vulcan salute: medium-light skin tone", "People & Body", "1.0"},
+	{"🖖🏽", "vulcan salute: medium skin tone", "People & Body", "1.0"},
+	{"🖖🏾", "vulcan salute: medium-dark skin tone", "People & Body", "1.0"},
+	{"🖖🏿", "vulcan salute: dark skin tone", "People & Body", "1.0"},
+	{"🫱", "rightwards hand", "People & Body", "14.0"},
+	{"🫱🏻", "rightwards hand: light skin tone", "People & Body", "14.0"},
+	{"🫱🏼", "rightwards hand: medium-light skin tone", "People & Body", "14.0"},
+	{"🫱🏽", "rightwards hand: medium skin tone", "People & Body", "14.0"},
+	{"🫱🏾", "rightwards hand: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫱🏿", "rightwards hand: dark skin tone", "People & Body", "14.0"},
+	{"🫲", "leftwards hand", "People & Body", "14.0"},
+	{"🫲🏻", "leftwards hand: light skin tone", "People & Body", "14.0"},
+	{"🫲🏼", "leftwards hand: medium-light skin tone", "People & Body", "14.0"},
+	{"🫲🏽", "leftwards hand: medium skin tone", "People & Body", "14.0"},
+	{"🫲🏾", "leftwards hand: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫲🏿", "leftwards hand: dark skin tone", "People & Body", "14.0"},
+	{"🫳", "palm down hand", "People & Body", "14.0"},
+	{"🫳🏻", "palm down hand: light skin tone", "People & Body", "14.0"},
+	{"🫳🏼", "palm down hand: medium-light skin tone", "People & Body", "14.0"},
+	{"🫳🏽", "palm down hand: medium skin tone", "People & Body", "14.0"},
+	{"🫳🏾", "palm down hand: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫳🏿", "palm down hand: dark skin tone", "People & Body", "14.0"},
+	{"🫴", "palm up hand", "People & Body", "14.0"},
+	{"🫴🏻", "palm up hand: light skin tone", "People & Body", "14.0"},
+	{"🫴🏼", "palm up hand: medium-light skin tone", "People & Body", "14.0"},
+	{"🫴🏽", "palm up hand: medium skin tone", "People & Body", "14.0"},
+	{"🫴🏾", "palm up hand: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫴🏿", "palm up hand: dark skin tone", "People & Body", "14.0"},
+	{"🫷", "leftwards pushing hand", "People & Body", "15.0"},
+	{"🫷🏻", "leftwards pushing hand: light skin tone", "People & Body", "15.0"},
+	{"🫷🏼", "leftwards pushing hand: medium-light skin tone", "People & Body", "15.0"},
+	{"🫷🏽", "leftwards pushing hand: medium skin tone", "People & Body", "15.0"},
+	{"🫷🏾", "leftwards pushing hand: medium-dark skin tone", "People & Body", "15.0"},
+	{"🫷🏿", "leftwards pushing hand: dark skin tone", "People & Body", "15.0"},
+	{"🫸", "rightwards pushing hand", "People & Body", "15.0"},
+	{"🫸🏻", "rightwards pushing hand: light skin tone", "People & Body", "15.0"},
+	{"🫸🏼", "rightwards pushing hand: medium-light skin tone", "People & Body", "15.0"},
+	{"🫸🏽", "rightwards pushing hand: medium skin tone", "People & Body", "15.0"},
+	{"🫸🏾", "rightwards pushing hand: medium-dark skin tone", "People & Body", "15.0"},
+	{"🫸🏿", "rightwards pushing hand: dark skin tone", "People & Body", "15.0"},
+	{"👌", "OK hand", "People & Body", "0.6"},
+	{"👌🏻", "OK hand: light skin tone", "People & Body", "1.0"},
+	{"👌🏼", "OK hand: medium-light skin tone", "People & Body", "1.0"},
+	{"👌🏽", "OK hand: medium skin tone", "People & Body", "1.0"},
+	{"👌🏾", "OK hand: medium-dark skin tone", "People & Body", "1.0"},
+	{"👌🏿", "OK hand: dark skin tone", "People & Body", "1.0"},
+	{"🤌", "pinched fingers", "People & Body", "13.0"},
+	{"🤌🏻", "pinched fingers: light skin tone", "People & Body", "13.0"},
+	{"🤌🏼", "pinched fingers: medium-light skin tone", "People & Body", "13.0"},
+	{"🤌🏽", "pinched fingers: medium skin tone", "People & Body", "13.0"},
+	{"🤌🏾", "pinched fingers: medium-dark skin tone", "People & Body", "13.0"},
+	{"🤌🏿", "pinched fingers: dark skin tone", "People & Body", "13.0"},
+	{"🤏", "pinching hand", "People & Body", "12.0"},
+	{"🤏🏻", "pinching hand: light skin tone", "People & Body", "12.0"},
+	{"🤏🏼", "pinching hand: medium-light skin tone", "People & Body", "12.0"},
+	{"🤏🏽", "pinching hand: medium skin tone", "People & Body", "12.0"},
+	{"🤏🏾", "pinching hand: medium-dark skin tone", "People & Body", "12.0"},
+	{"🤏🏿", "pinching hand: dark skin tone", "People & Body", "12.0"},
+	{"✌️", "victory hand", "People & Body", "0.6"},
+	{"✌🏻", "victory hand: light skin tone", "People & Body", "1.0"},
+	{"✌🏼", "victory hand: medium-light skin tone", "People & Body", "1.0"},
+	{"✌🏽", "victory hand: medium skin tone", "People & Body", "1.0"},
+	{"✌🏾", "victory hand: medium-dark skin tone", "People & Body", "1.0"},
+	{"✌🏿", "victory hand: dark skin tone", "People & Body", "1.0"},
+	{"🤞", "crossed fingers", "People & Body", "3.0"},
+	{"🤞🏻", "crossed fingers: light skin tone", "People & Body", "3.0"},
+	{"🤞🏼", "crossed fingers: medium-light skin tone", "People & Body", "3.0"},
+	{"🤞🏽", "crossed fingers: medium skin tone", "People & Body", "3.0"},
+	{"🤞🏾", "crossed fingers: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤞🏿", "crossed fingers: dark skin tone", "People & Body", "3.0"},
+	{"🫰", "hand with index finger and thumb crossed", "People & Body", "14.0"},
+	{"🫰🏻", "hand with index finger and thumb crossed: light skin tone", "People & Body", "14.0"},
+	{"🫰🏼", "hand with index finger and thumb crossed: medium-light skin tone", "People & Body", "14.0"},
+	{"🫰🏽", "hand with index finger and thumb crossed: medium skin tone", "People & Body", "14.0"},
+	{"🫰🏾", "hand with index finger and thumb crossed: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫰🏿", "hand with index finger and thumb crossed: dark skin tone", "People & Body", "14.0"},
+	{"🤟", "love-you gesture", "People & Body", "5.0"},
+	{"🤟🏻", "love-you gesture: light skin tone", "People & Body", "5.0"},
+	{"🤟🏼", "love-you gesture: medium-light skin tone", "People & Body", "5.0"},
+	{"🤟🏽", "love-you gesture: medium skin tone", "People & Body", "5.0"},
+	{"🤟🏾", "love-you gesture: medium-dark skin tone", "People & Body", "5.0"},
+	{"🤟🏿", "love-you gesture: dark skin tone", "People & Body", "5.0"},
+	{"🤘", "sign of the horns", "People & Body", "1.0"},
+	{"🤘🏻", "sign of the horns: light skin tone", "People & Body", "1.0"},
+	{"🤘🏼", "sign of the horns: medium-light skin tone", "People & Body", "1.0"},
+	{"🤘🏽", "sign of the horns: medium skin tone", "People & Body", "1.0"},
+	{"🤘🏾", "sign of the horns: medium-dark skin tone", "People & Body", "1.0"},
+	{"🤘🏿", "sign of the horns: dark skin tone", "People & Body", "1.0"},
+	{"🤙", "call me hand", "People & Body", "3.0"},
+	{"🤙🏻", "call me hand: light skin tone", "People & Body", "3.0"},
+	{"🤙🏼", "call me hand: medium-light skin tone", "People & Body", "3.0"},
+	{"🤙🏽", "call me hand: medium skin tone", "People & Body", "3.0"},
+	{"🤙🏾", "call me hand: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤙🏿", "call me hand: dark skin tone", "People & Body", "3.0"},
+	{"👈", "backhand index pointing left", "People & Body", "0.6"},
+	{"👈🏻", "backhand index pointing left: light skin tone", "People & Body", "1.0"},
+	{"👈🏼", "backhand index pointing left: medium-light skin tone", "People & Body", "1.0"},
+	{"👈🏽", "backhand index pointing left: medium skin tone", "People & Body", "1.0"},
+	{"👈🏾", "backhand index pointing left: medium-dark skin tone", "People & Body", "1.0"},
+	{"👈🏿", "backhand index pointing left: dark skin tone", "People & Body", "1.0"},
+	{"👉", "backhand index pointing right", "People & Body", "0.6"},
+	{"👉🏻", "backhand index pointing right: light skin tone", "People & Body", "1.0"},
+	{"👉🏼", "backhand index pointing right: medium-light skin tone", "People & Body", "1.0"},
+	{"👉🏽", "backhand index pointing right: medium skin tone", "People & Body", "1.0"},
+	{"👉🏾", "backhand index pointing right: medium-dark skin tone", "People & Body", "1.0"},
+	{"👉🏿", "backhand index pointing right: dark skin tone", "People & Body", "1.0"},
+	{"👆", "backhand index pointing up", "People & Body", "0.6"},
+	{"👆🏻", "backhand index pointing up: light skin tone", "People & Body", "1.0"},
+	{"👆🏼", "backhand index pointing up: medium-light skin tone", "People & Body", "1.0"},
+	{"👆🏽", "backhand index pointing up: medium skin tone", "People & Body", "1.0"},
+	{"👆🏾", "backhand index pointing up: medium-dark skin tone", "People & Body", "1.0"},
+	{"👆🏿", "backhand index pointing up: dark skin tone", "People & Body", "1.0"},
+	{"🖕", "middle finger", "People & Body", "1.0"},
+	{"🖕🏻", "middle finger: light skin tone", "People & Body", "1.0"},
+	{"🖕🏼", "middle finger: medium-light skin tone", "People & Body", "1.0"},
+	{"🖕🏽", "middle finger: medium skin tone", "People & Body", "1.0"},
+	{"🖕🏾", "middle finger: medium-dark skin tone", "People & Body", "1.0"},
+	{"🖕🏿", "middle finger: dark skin tone", "People & Body", "1.0"},
+	{"👇", "backhand index pointing down", "People & Body", "0.6"},
+	{"👇🏻", "backhand index pointing down: light skin tone", "People & Body", "1.0"},
+	{"👇🏼", "backhand index pointing down: medium-light skin tone", "People & Body", "1.0"},
+	{"👇🏽", "backhand index pointing down: medium skin tone", "People & Body", "1.0"},
+	{"👇🏾", "backhand index pointing down: medium-dark skin tone", "People & Body", "1.0"},
+	{"👇🏿", "backhand index pointing down: dark skin tone", "People & Body", "1.0"},
+	{"☝️", "index pointing up", "People & Body", "0.6"},
+	{"☝🏻", "index pointing up: light skin tone", "People & Body", "1.0"},
+	{"☝🏼", "index pointing up: medium-light skin tone", "People & Body", "1.0"},
+	{"☝🏽", "index pointing up: medium skin tone", "People & Body", "1.0"},
+	{"☝🏾", "index pointing up: medium-dark skin tone", "People & Body", "1.0"},
+	{"☝🏿", "index pointing up: dark skin tone", "People & Body", "1.0"},
+	{"🫵", "index pointing at the viewer", "People & Body", "14.0"},
+	{"🫵🏻", "index pointing at the viewer: light skin tone", "People & Body", "14.0"},
+	{"🫵🏼", "index pointing at the viewer: medium-light skin tone", "People & Body", "14.0"},
+	{"🫵🏽", "index pointing at the viewer: medium skin tone", "People & Body", "14.0"},
+	{"🫵🏾", "index pointing at the viewer: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫵🏿", "index pointing at the viewer: dark skin tone", "People & Body", "14.0"},
+	{"👍", "thumbs up", "People & Body", "0.6"},
+	{"👍🏻", "thumbs up: light skin tone", "People & Body", "1.0"},
+	{"👍🏼", "thumbs up: medium-light skin tone", "People & Body", "1.0"},
+	{"👍🏽", "thumbs up: medium skin tone", "People & Body", "1.0"},
+	{"👍🏾", "thumbs up: medium-dark skin tone", "People & Body", "1.0"},
+	{"👍🏿", "thumbs up: dark skin tone", "People & Body", "1.0"},
+	{"👎", "thumbs down", "People & Body", "0.6"},
+	{"👎🏻", "thumbs down: light skin tone", "People & Body", "1.0"},
+	{"👎🏼", "thumbs down: medium-light skin tone", "People & Body", "1.0"},
+	{"👎🏽", "thumbs down: medium skin tone", "People & Body", "1.0"},
+	{"👎🏾", "thumbs down: medium-dark skin tone", "People & Body", "1.0"},
+	{"👎🏿", "thumbs down: dark skin tone", "People & Body", "1.0"},
+	{"✊", "raised fist", "People & Body", "0.6"},
+	{"✊🏻", "raised fist: light skin tone", "People & Body", "1.0"},
+	{"✊🏼", "raised fist: medium-light skin tone", "People & Body", "1.0"},
+	{"✊🏽", "raised fist: medium skin tone", "People & Body", "1.0"},
+	{"✊🏾", "raised fist: medium-dark skin tone", "People & Body", "1.0"},
+	{"✊🏿", "raised fist: dark skin tone", "People & Body", "1.0"},
+	{"👊", "oncoming fist", "People & Body", "0.6"},
+	{"👊🏻", "oncoming fist: light skin tone", "People & Body", "1.0"},
+	{"👊🏼", "oncoming fist: medium-light skin tone", "People & Body", "1.0"},
+	{"👊🏽", "oncoming fist: medium skin tone", "People & Body", "1.0"},
+	{"👊🏾", "oncoming fist: medium-dark skin tone", "People & Body", "1.0"},
+	{"👊🏿", "oncoming fist: dark skin tone", "People & Body", "1.0"},
+	{"🤛", "left-facing fist", "People & Body", "3.0"},
+	{"🤛🏻", "left-facing fist: light skin tone", "People & Body", "3.0"},
+	{"🤛🏼", "left-facing fist: medium-light skin tone", "People & Body", "3.0"},
+	{"🤛🏽", "left-facing fist: medium skin tone", "People & Body", "3.0"},
+	{"🤛🏾", "left-facing fist: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤛🏿", "left-facing fist: dark skin tone", "People & Body", "3.0"},
+	{"🤜", "right-facing fist", "People & Body", "3.0"},
+	{"🤜🏻", "right-facing fist: light skin tone", "People & Body", "3.0"},
+	{"🤜🏼", "right-facing fist: medium-light skin tone", "People & Body", "3.0"},
+	{"🤜🏽", "right-facing fist: medium skin tone", "People & Body", "3.0"},
+	{"🤜🏾", "right-facing fist: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤜🏿", "right-facing fist: dark skin tone", "People & Body", "3.0"},
+	{"👏", "clapping hands", "People & Body", "0.6"},
+	{"👏🏻", "clapping hands: light skin tone", "People & Body", "1.0"},
+	{"👏🏼", "clapping hands: medium-light skin tone", "People & Body", "1.0"},
+	{"👏🏽", "clapping hands: medium skin tone", "People & Body", "1.0"},
+	{"👏🏾", "clapping hands: medium-dark skin tone", "People & Body", "1.0"},
+	{"👏🏿", "clapping hands: dark skin tone", "People & Body", "1.0"},
+	{"🙌", "raising hands", "People & Body", "0.6"},
+	{"🙌🏻", "raising hands: light skin tone", "People & Body", "1.0"},
+	{"🙌🏼", "raising hands: medium-light skin tone", "People & Body", "1.0"},
+	{"🙌🏽", "raising hands: medium skin tone", "People & Body", "1.0"},
+	{"🙌🏾", "raising hands: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙌🏿", "raising hands: dark skin tone", "People & Body", "1.0"},
+	{"🫶", "heart hands", "People & Body", "14.0"},
+	{"🫶🏻", "heart hands: light skin tone", "People & Body", "14.0"},
+	{"🫶🏼", "heart hands: medium-light skin tone", "People & Body", "14.0"},
+	{"🫶🏽", "heart hands: medium skin tone", "People & Body", "14.0"},
+	{"🫶🏾", "heart hands: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫶🏿", "heart hands: dark skin tone", "People & Body", "14.0"},
+	{"👐", "open hands", "People & Body", "0.6"},
+	{"👐🏻", "open hands: light skin tone", "People & Body", "1.0"},
+	{"👐🏼", "open hands: medium-light skin tone", "People & Body", "1.0"},
+	{"👐🏽", "open hands: medium skin tone", "People & Body", "1.0"},
+	{"👐🏾", "open hands: medium-dark skin tone", "People & Body", "1.0"},
+	{"👐🏿", "open hands: dark skin tone", "People & Body", "1.0"},
+	{"🤲", "palms up together", "People & Body", "5.0"},
+	{"🤲🏻", "palms up together: light skin tone", "People & Body", "5.0"},
+	{"🤲🏼", "palms up together: medium-light skin tone", "People & Body", "5.0"},
+	{"🤲🏽", "palms up together: medium skin tone", "People & Body", "5.0"},
+	{"🤲🏾", "palms up together: medium-dark skin tone", "People & Body", "5.0"},
+	{"🤲🏿", "palms up together: dark skin tone", "People & Body", "5.0"},
+	{"🤝", "handshake", "People & Body", "3.0"},
+	{"🤝🏻", "handshake: light skin tone", "People & Body", "14.0"},
+	{"🤝🏼", "handshake: medium-light skin tone", "People & Body", "14.0"},
+	{"🤝🏽", "handshake: medium skin tone", "People & Body", "14.0"},
+	{"🤝🏾", "handshake: medium-dark skin tone", "People & Body", "14.0"},
+	{"🤝🏿", "handshake: dark skin tone", "People & Body", "14.0"},
+	{"🫱🏻\u200d🫲🏼", "handshake: light skin tone, medium-light skin tone", "People & Body", "14.0"},
+	{"🫱🏻\u200d🫲🏽", "handshake: light skin tone, medium skin tone", "People & Body", "14.0"},
+	{"🫱🏻\u200d🫲🏾", "handshake: light skin tone, medium-dark skin tone", "People & Body", "14.0"},
+	{"🫱🏻\u200d🫲🏿", "handshake: light skin tone, dark skin tone", "People & Body", "14.0"},
+	{"🫱🏼\u200d🫲🏻", "handshake: medium-light skin tone, light skin tone", "People & Body", "14.0"},
+	{"🫱🏼\u200d🫲🏽", "handshake: medium-light skin tone, medium skin tone", "People & Body", "14.0"},
+	{"🫱🏼\u200d🫲🏾", "handshake: medium-light skin tone, medium-dark skin tone", "People & Body", "14.0"},
+	{"🫱🏼\u200d🫲🏿", "handshake: medium-light skin tone, dark skin tone", "People & Body", "14.0"},
+	{"🫱🏽\u200d🫲🏻", "handshake: medium skin tone, light skin tone", "People & Body", "14.0"},
+	{"🫱🏽\u200d🫲🏼", "handshake: medium skin tone, medium-light skin tone", "People & Body", "14.0"},
+	{"🫱🏽\u200d🫲🏾", "handshake: medium skin tone, medium-dark skin tone", "People & Body", "14.0"},
+	{"🫱🏽\u200d🫲🏿", "handshake: medium skin tone, dark skin tone", "People & Body", "14.0"},
+	{"🫱🏾\u200d🫲🏻", "handshake: medium-dark skin tone, light skin tone", "People & Body", "14.0"},
+	{"🫱🏾\u200d🫲🏼", "handshake: medium-dark skin tone, medium-light skin tone", "People & Body", "14.0"},
+	{"🫱🏾\u200d🫲🏽", "handshake: medium-dark skin tone, medium skin tone", "People & Body", "14.0"},
+	{"🫱🏾\u200d🫲🏿", "handshake: medium-dark skin tone, dark skin tone", "People & Body", "14.0"},
+	{"🫱🏿\u200d🫲🏻", "handshake: dark skin tone, light skin tone", "People & Body", "14.0"},
+	{"🫱🏿\u200d🫲🏼", "handshake: dark skin tone, medium-light skin tone", "People & Body", "14.0"},
+	{"🫱🏿\u200d🫲🏽", "handshake: dark skin tone, medium skin tone", "People & Body", "14.0"},
+	{"🫱🏿\u200d🫲🏾", "handshake: dark skin tone, medium-dark skin tone", "People & Body", "14.0"},
+	{"🙏", "folded hands", "People & Body", "0.6"},
+	{"🙏🏻", "folded hands: light skin tone", "People & Body", "1.0"},
+	{"🙏🏼", "folded hands: medium-light skin tone", "People & Body", "1.0"},
+	{"🙏🏽", "folded hands: medium skin tone", "People & Body", "1.0"},
+	{"🙏🏾", "folded hands: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙏🏿", "folded hands: dark skin tone", "People & Body", "1.0"},
+	{"✍️", "writing hand", "People & Body", "0.7"},
+	{"✍🏻", "writing hand: light skin tone", "People & Body", "1.0"},
+	{"✍🏼", "writing hand: medium-light skin tone", "People & Body", "1.0"},
+	{"✍🏽", "writing hand: medium skin tone", "People & Body", "1.0"},
+	{"✍🏾", "writing hand: medium-dark skin tone", "People & Body", "1.0"},
+	{"✍🏿", "writing hand: dark skin tone", "People & Body", "1.0"},
+	{"💅", "nail polish", "People & Body", "0.6"},
+	{"💅🏻", "nail polish: light skin tone", "People & Body", "1.0"},
+	{"💅🏼", "nail polish: medium-light skin tone", "People & Body", "1.0"},
+	{"💅🏽", "nail polish: medium skin tone", "People & Body", "1.0"},
+	{"💅🏾", "nail polish: medium-dark skin tone", "People & Body", "1.0"},
+	{"💅🏿", "nail polish: dark skin tone", "People & Body", "1.0"},
+	{"🤳", "selfie", "People & Body", "3.0"},
+	{"🤳🏻", "selfie: light skin tone", "People & Body", "3.0"},
+	{"🤳🏼", "selfie: medium-light skin tone", "People & Body", "3.0"},
+	{"🤳🏽", "selfie: medium skin tone", "People & Body", "3.0"},
+	{"🤳🏾", "selfie: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤳🏿", "selfie: dark skin tone", "People & Body", "3.0"},
+	{"💪", "flexed biceps", "People & Body", "0.6"},
+	{"💪🏻", "flexed biceps: light skin tone", "People & Body", "1.0"},
+	{"💪🏼", "flexed biceps: medium-light skin tone", "People & Body", "1.0"},
+	{"💪🏽", "flexed biceps: medium skin tone", "People & Body", "1.0"},
+	{"💪🏾", "flexed biceps: medium-dark skin tone", "People & Body", "1.0"},
+	{"💪🏿", "flexed biceps: dark skin tone", "People & Body", "1.0"},
+	{"🦾", "mechanical arm", "People & Body", "12.0"},
+	{"🦿", "mechanical leg", "People & Body", "12.0"},
+	{"🦵", "leg", "People & Body", "11.0"},
+	{"🦵🏻", "leg: light skin tone", "People & Body", "11.0"},
+	{"🦵🏼", "leg: medium-light skin tone", "People & Body", "11.0"},
+	{"🦵🏽", "leg: medium skin tone", "People & Body", "11.0"},
+	{"🦵🏾", "leg: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦵🏿", "leg: dark skin tone", "People & Body", "11.0"},
+	{"🦶", "foot", "People & Body", "11.0"},
+	{"🦶🏻", "foot: light skin tone", "People & Body", "11.0"},
+	{"🦶🏼", "foot: medium-light skin tone", "People & Body", "11.0"},
+	{"🦶🏽", "foot: medium skin tone", "People & Body", "11.0"},
+	{"🦶🏾", "foot: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦶🏿", "foot: dark skin tone", "People & Body", "11.0"},
+	{"👂", "ear", "People & Body", "0.6"},
+	{"👂🏻", "ear: light skin tone", "People & Body", "1.0"},
+	{"👂🏼", "ear: medium-light skin tone", "People & Body", "1.0"},
+	{"👂🏽", "ear: medium skin tone", "People & Body", "1.0"},
+	{"👂🏾", "ear: medium-dark skin tone", "People & Body", "1.0"},
+	{"👂🏿", "ear: dark skin tone", "People & Body", "1.0"},
+	{"🦻", "ear with hearing aid", "People & Body", "12.0"},
+	{"🦻🏻", "ear with hearing aid: light skin tone", "People & Body", "12.0"},
+	{"🦻🏼", "ear with hearing aid: medium-light skin tone", "People & Body", "12.0"},
+	{"🦻🏽", "ear with hearing aid: medium skin tone", "People & Body", "12.0"},
+	{"🦻🏾", "ear with hearing aid: medium-dark skin tone", "People & Body", "12.0"},
+	{"🦻🏿", "ear with hearing aid: dark skin tone", "People & Body", "12.0"},
+	{"👃", "nose", "People & Body", "0.6"},
+	{"👃🏻", "nose: light skin tone", "People & Body", "1.0"},
+	{"👃🏼", "nose: medium-light skin tone", "People & Body", "1.0"},
+	{"👃🏽", "nose: medium skin tone", "People & Body", "1.0"},
+	{"👃🏾", "nose: medium-dark skin tone", "People & Body", "1.0"},
+	{"👃🏿", "nose: dark skin tone", "People & Body", "1.0"},
+	{"🧠", "brain", "People & Body", "5.0"},
+	{"🫀", "anatomical heart", "People & Body", "13.0"},
+	{"🫁", "lungs", "People & Body", "13.0"},
+	{"🦷", "tooth", "People & Body", "11.0"},
+	{"🦴", "bone", "People & Body", "11.0"},
+	{"👀", "eyes", "People & Body", "0.6"},
+	{"👁️", "eye", "People & Body", "0.7"},
+	{"👅", "tongue", "People & Body", "0.6"},
+	{"👄", "mouth", "People & Body", "0.6"},
+	{"🫦", "biting lip", "People & Body", "14.0"},
+	{"👶", "baby", "People & Body", "0.6"},
+	{"👶🏻", "baby: light skin tone", "People & Body", "1.0"},
+	{"👶🏼", "baby: medium-light skin tone", "People & Body", "1.0"},
+	{"👶🏽", "baby: medium skin tone", "People & Body", "1.0"},
+	{"👶🏾", "baby: medium-dark skin tone", "People & Body", "1.0"},
+	{"👶🏿", "baby: dark skin tone", "People & Body", "1.0"},
+	{"🧒", "child", "People & Body", "5.0"},
+	{"🧒🏻", "child: light skin tone", "People & Body", "5.0"},
+	{"🧒🏼", "child: medium-light skin tone", "People & Body", "5.0"},
+	{"🧒🏽", "child: medium skin tone", "People & Body", "5.0"},
+	{"🧒🏾", "child: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧒🏿", "child: dark skin tone", "People & Body", "5.0"},
+	{"👦", "boy", "People & Body", "0.6"},
+	{"👦🏻", "boy: light skin tone", "People & Body", "1.0"},
+	{"👦🏼", "boy: medium-light skin tone", "People & Body", "1.0"},
+	{"👦🏽", "boy: medium skin tone", "People & Body", "1.0"},
+	{"👦🏾", "boy: medium-dark skin tone", "People & Body", "1.0"},
+	{"👦🏿", "boy: dark skin tone", "People & Body", "1.0"},
+	{"👧", "girl", "People & Body", "0.6"},
+	{"👧🏻", "girl: light skin tone", "People & Body", "1.0"},
+	{"👧🏼", "girl: medium-light skin tone", "People & Body", "1.0"},
+	{"👧🏽", "girl: medium skin tone", "People & Body", "1.0"},
+	{"👧🏾", "girl: medium-dark skin tone", "People & Body", "1.0"},
+	{"👧🏿", "girl: dark skin tone", "People & Body", "1.0"},
+	{"🧑", "person", "People & Body", "5.0"},
+	{"🧑🏻", "person: light skin tone", "People & Body", "5.0"},
+	{"🧑🏼", "person: medium-light skin tone", "People & Body", "5.0"},
+	{"🧑🏽", "person: medium skin tone", "People & Body", "5.0"},
+	{"🧑🏾", "person: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧑🏿", "person: dark skin tone", "People & Body", "5.0"},
+	{"👱", "person: blond hair", "People & Body", "0.6"},
+	{"👱🏻", "person: light skin tone, blond hair", "People & Body", "1.0"},
+	{"👱🏼", "person: medium-light skin tone, blond hair", "People & Body", "1.0"},
+	{"👱🏽", "person: medium skin tone, blond hair", "People & Body", "1.0"},
+	{"👱🏾", "person: medium-dark skin tone, blond hair", "People & Body", "1.0"},
+	{"👱🏿", "person: dark skin tone, blond hair", "People & Body", "1.0"},
+	{"👨", "man", "People & Body", "0.6"},
+	{"👨🏻", "man: light skin tone", "People & Body", "1.0"},
+	{"👨🏼", "man: medium-light skin tone", "People & Body", "1.0"},
+	{"👨🏽", "man: medium skin tone", "People & Body", "1.0"},
+	{"👨🏾", "man: medium-dark skin tone", "People & Body", "1.0"},
+	{"👨🏿", "man: dark skin tone", "People & Body", "1.0"},
+	{"🧔", "person: beard", "People & Body", "5.0"},
+	{"🧔🏻", "person: light skin tone, beard", "People & Body", "5.0"},
+	{"🧔🏼", "person: medium-light skin tone, beard", "People & Body", "5.0"},
+	{"🧔🏽", "person: medium skin tone, beard", "People & Body", "5.0"},
+	{"🧔🏾", "person: medium-dark skin tone, beard", "People & Body", "5.0"},
+	{"🧔🏿", "person: dark skin tone, beard", "People & Body", "5.0"},
+	{"🧔\u200d♂️", "man: beard", "People & Body", "13.1"},
+	{"🧔🏻\u200d♂️", "man: light skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏼\u200d♂️", "man: medium-light skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏽\u200d♂️", "man: medium skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏾\u200d♂️", "man: medium-dark skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏿\u200d♂️", "man: dark skin tone, beard", "People & Body", "13.1"},
+	{"🧔\u200d♀️", "woman: beard", "People & Body", "13.1"},
+	{"🧔🏻\u200d♀️", "woman: light skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏼\u200d♀️", "woman: medium-light skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏽\u200d♀️", "woman: medium skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏾\u200d♀️", "woman: medium-dark skin tone, beard", "People & Body", "13.1"},
+	{"🧔🏿\u200d♀️", "woman: dark skin tone, beard", "People & Body", "13.1"},
+	{"👨\u200d🦰", "man: red hair", "People & Body", "11.0"},
+	{"👨🏻\u200d🦰", "man: light skin tone, red hair", "People & Body", "11.0"},
+	{"👨🏼\u200d🦰", "man: medium-light skin tone, red hair", "People & Body", "11.0"},
+	{"👨🏽\u200d🦰", "man: medium skin tone, red hair", "People & Body", "11.0"},
+	{"👨🏾\u200d🦰", "man: medium-dark skin tone, red hair", "People & Body", "11.0"},
+	{"👨🏿\u200d🦰", "man: dark skin tone, red hair", "People & Body", "11.0"},
+	{"👨\u200d🦱", "man: curly hair", "People & Body", "11.0"},
+	{"👨🏻\u200d🦱", "man: light skin tone, curly hair", "People & Body", "11.0"},
+	{"👨🏼\u200d🦱", "man: medium-light skin tone, curly hair", "People & Body", "11.0"},
+	{"👨🏽\u200d🦱", "man: medium skin tone, curly hair", "People & Body", "11.0"},
+	{"👨🏾\u200d🦱", "man: medium-dark skin tone, curly hair", "People & Body", "11.0"},
+	{"👨🏿\u200d🦱", "man: dark skin tone, curly hair", "People & Body", "11.0"},
+	{"👨\u200d🦳", "man: white hair", "People & Body", "11.0"},
+	{"👨🏻\u200d🦳", "man: light skin tone, white hair", "People & Body", "11.0"},
+	{"👨🏼\u200d🦳", "man: medium-light skin tone, white hair", "People & Body", "11.0"},
+	{"👨🏽\u200d🦳", "man: medium skin tone, white hair", "People & Body", "11.0"},
+	{"👨🏾\u200d🦳", "man: medium-dark skin tone, white hair", "People & Body", "11.0"},
+	{"👨🏿\u200d🦳", "man: dark skin tone, white hair", "People & Body", "11.0"},
+	{"👨\u200d🦲", "man: bald", "People & Body", "11.0"},
+	{"👨🏻\u200d🦲", "man: light skin tone, bald", "People & Body", "11.0"},
+	{"👨🏼\u200d🦲", "man: medium-light skin tone, bald", "People & Body", "11.0"},
+	{"👨🏽\u200d🦲", "man: medium skin tone, bald", "People & Body", "11.0"},
+	{"👨🏾\u200d🦲", "man: medium-dark skin tone, bald", "People & Body", "11.0"},
+	{"👨🏿\u200d🦲", "man: dark skin tone, bald", "People & Body", "11.0"},
+	{"👩", "woman", "People & Body", "0.6"},
+	{"👩🏻", "woman: light skin tone", "People & Body", "1.0"},
+	{"👩🏼", "woman: medium-light skin tone", "People & Body", "1.0"},
+	{"👩🏽", "woman: medium skin tone", "People & Body", "1.0"},
+	{"👩🏾", "woman: medium-dark skin tone", "People & Body", "1.0"},
+	{"👩🏿", "woman: dark skin tone", "People & Body", "1.0"},
+	{"👩\u200d🦰", "woman: red hair", "People & Body", "11.0"},
+	{"👩🏻\u200d🦰", "woman: light skin tone, red hair", "People & Body", "11.0"},
+	{"👩🏼\u200d🦰", "woman: medium-light skin tone, red hair", "People & Body", "11.0"},
+	{"👩🏽\u200d🦰", "woman: medium skin tone, red hair", "People & Body", "11.0"},
+	{"👩🏾\u200d🦰", "woman: medium-dark skin tone, red hair", "People & Body", "11.0"},
+	{"👩🏿\u200d🦰", "woman: dark skin tone, red hair", "People & Body", "11.0"},
+	{"🧑\u200d🦰", "person: red hair", "People & Body", "12.1"},
+	{"🧑🏻\u200d🦰", "person: light skin tone, red hair", "People & Body", "12.1"},
+	{"🧑🏼\u200d🦰", "person: medium-light skin tone, red hair", "People & Body", "12.1"},
+	{"🧑🏽\u200d🦰", "person: medium skin tone, red hair", "People & Body", "12.1"},
+	{"🧑🏾\u200d🦰", "person: medium-dark skin tone, red hair", "People & Body", "12.1"},
+	{"🧑🏿\u200d🦰", "person: dark skin tone, red hair", "People & Body", "12.1"},
+	{"👩\u200d🦱", "woman: curly hair", "People & Body", "11.0"},
+	{"👩🏻\u200d🦱", "woman: light skin tone, curly hair", "People & Body", "11.0"},
+	{"👩🏼\u200d🦱", "woman: medium-light skin tone, curly hair", "People & Body", "11.0"},
+	{"👩🏽\u200d🦱", "woman: medium skin tone, curly hair", "People & Body", "11.0"},
+	{"👩🏾\u200d🦱", "woman: medium-dark skin tone, curly hair", "People & Body", "11.0"},
+	{"👩🏿\u200d🦱", "woman: dark skin tone, curly hair", "People & Body", "11.0"},
+	{"🧑\u200d🦱", "person: curly hair", "People & Body", "12.1"},
+	{"🧑🏻\u200d🦱", "person: light skin tone, curly hair", "People & Body", "12.1"},
+	{"🧑🏼\u200d🦱", "person: medium-light skin tone, curly hair", "People & Body", "12.1"},
+	{"🧑🏽\u200d🦱", "person: medium skin tone, curly hair", "People & Body", "12.1"},
+	{"🧑🏾\u200d🦱", "person: medium-dark skin tone, curly hair", "People & Body", "12.1"},
+	{"🧑🏿\u200d🦱", "person: dark skin tone, curly hair", "People & Body", "12.1"},
+	{"👩\u200d🦳", "woman: white hair", "People & Body", "11.0"},
+	{"👩🏻\u200d🦳", "woman: light skin tone, white hair", "People & Body", "11.0"},
+	{"👩🏼\u200d🦳", "woman: medium-light skin tone, white hair", "People & Body", "11.0"},
+	{"👩🏽\u200d🦳", "woman: medium skin tone, white hair", "People & Body", "11.0"},
+	{"👩🏾\u200d🦳", "woman: medium-dark skin tone, white hair", "People & Body", "11.0"},
+	{"👩🏿\u200d🦳", "woman: dark skin tone, white hair", "People & Body", "11.0"},
+	{"🧑\u200d🦳", "person: white hair", "People & Body", "12.1"},
+	{"🧑🏻\u200d🦳", "person: light skin tone, white hair", "People & Body", "12.1"},
+	{"🧑🏼\u200d🦳", "person: medium-light skin tone, white hair", "People & Body", "12.1"},
+	{"🧑🏽\u200d🦳", "person: medium skin tone, white hair", "People & Body", "12.1"},
+	{"🧑🏾\u200d🦳", "person: medium-dark skin tone, white hair", "People & Body", "12.1"},
+	{"🧑🏿\u200d🦳", "person: dark skin tone, white hair", "People & Body", "12.1"},
+	{"👩\u200d🦲", "woman: bald", "People & Body", "11.0"},
+	{"👩🏻\u200d🦲", "woman: light skin tone, bald", "People & Body", "11.0"},
+	{"👩🏼\u200d🦲", "woman: medium-light skin tone, bald", "People & Body", "11.0"},
+	{"👩🏽\u200d🦲", "woman: medium skin tone, bald", "People & Body", "11.0"},
+	{"👩🏾\u200d🦲", "woman: medium-dark skin tone, bald", "People & Body", "11.0"},
+	{"👩🏿\u200d🦲", "woman: dark skin tone, bald", "People & Body", "11.0"},
+	{"🧑\u200d🦲", "person: bald", "People & Body", "12.1"},
+	{"🧑🏻\u200d🦲", "person: light skin tone, bald", "People & Body", "12.1"},
+	{"🧑🏼\u200d🦲", "person: medium-light skin tone, bald", "People & Body", "12.1"},
+	{"🧑🏽\u200d🦲", "person: medium skin tone, bald", "People & Body", "12.1"},
+	{"🧑🏾\u200d🦲", "person: medium-dark skin tone, bald", "People & Body", "12.1"},
+	{"🧑🏿\u200d🦲", "person: dark skin tone, bald", "People & Body", "12.1"},
+	{"👱\u200d♀️", "woman: blond hair", "People & Body", "4.0"},
+	{"👱🏻\u200d♀️", "woman: light skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏼\u200d♀️", "woman: medium-light skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏽\u200d♀️", "woman: medium skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏾\u200d♀️", "woman: medium-dark skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏿\u200d♀️", "woman: dark skin tone, blond hair", "People & Body", "4.0"},
+	{"👱\u200d♂️", "man: blond hair", "People & Body", "4.0"},
+	{"👱🏻\u200d♂️", "man: light skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏼\u200d♂️", "man: medium-light skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏽\u200d♂️", "man: medium skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏾\u200d♂️", "man: medium-dark skin tone, blond hair", "People & Body", "4.0"},
+	{"👱🏿\u200d♂️", "man: dark skin tone, blond hair", "People & Body", "4.0"},
+	{"🧓", "older person", "People & Body", "5.0"},
+	{"🧓🏻", "older person: light skin tone", "People & Body", "5.0"},
+	{"🧓🏼", "older person: medium-light skin tone", "People & Body", "5.0"},
+	{"🧓🏽", "older person: medium skin tone", "People & Body", "5.0"},
+	{"🧓🏾", "older person: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧓🏿", "older person: dark skin tone", "People & Body", "5.0"},
+	{"👴", "old man", "People & Body", "0.6"},
+	{"👴🏻", "old man: light skin tone", "People & Body", "1.0"},
+	{"👴🏼", "old man: medium-light skin tone", "People & Body", "1.0"},
+	{"👴🏽", "old man: medium skin tone", "People & Body", "1.0"},
+	{"👴🏾", "old man: medium-dark skin tone", "People & Body", "1.0"},
+	{"👴🏿", "old man: dark skin tone", "People & Body", "1.0"},
+	{"👵", "old woman", "People & Body", "0.6"},
+	{"👵🏻", "old woman: light skin tone", "People & Body", "1.0"},
+	{"👵🏼", "old woman: medium-light skin tone", "People & Body", "1.0"},
+	{"👵🏽", "old woman: medium skin tone", "People & Body", "1.0"},
+	{"👵🏾", "old woman: medium-dark skin tone", "People & Body", "1.0"},
+	{"👵🏿", "old woman: dark skin tone", "People & Body", "1.0"},
+	{"🙍", "person frowning", "People & Body", "0.6"},
+	{"🙍🏻", "person frowning: light skin tone", "People & Body", "1.0"},
+	{"🙍🏼", "person frowning: medium-light skin tone", "People & Body", "1.0"},
+	{"🙍🏽", "person frowning: medium skin tone", "People & Body", "1.0"},
+	{"🙍🏾", "person frowning: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙍🏿", "person frowning: dark skin tone", "People & Body", "1.0"},
+	{"🙍\u200d♂️", "man frowning", "People & Body", "4.0"},
+	{"🙍🏻\u200d♂️", "man frowning: light skin tone", "People & Body", "4.0"},
+	{"🙍🏼\u200d♂️", "man frowning: medium-light skin tone", "People & Body", "4.0"},
+	{"🙍🏽\u200d♂️", "man frowning: medium skin tone", "People & Body", "4.0"},
+	{"🙍🏾\u200d♂️", "man frowning: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙍🏿\u200d♂️", "man frowning: dark skin tone", "People & Body", "4.0"},
+	{"🙍\u200d♀️", "woman frowning", "People & Body", "4.0"},
+	{"🙍🏻\u200d♀️", "woman frowning: light skin tone", "People & Body", "4.0"},
+	{"🙍🏼\u200d♀️", "woman frowning: medium-light skin tone", "People & Body", "4.0"},
+	{"🙍🏽\u200d♀️", "woman frowning: medium skin tone", "People & Body", "4.0"},
+	{"🙍🏾\u200d♀️", "woman frowning: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙍🏿\u200d♀️", "woman frowning: dark skin tone", "People & Body", "4.0"},
+	{"🙎", "person pouting", "People & Body", "0.6"},
+	{"🙎🏻", "person pouting: light skin tone", "People & Body", "1.0"},
+	{"🙎🏼", "person pouting: medium-light skin tone", "People & Body", "1.0"},
+	{"🙎🏽", "person pouting: medium skin tone", "People & Body", "1.0"},
+	{"🙎🏾", "person pouting: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙎🏿", "person pouting: dark skin tone", "People & Body", "1.0"},
+	{"🙎\u200d♂️", "man pouting", "People & Body", "4.0"},
+	{"🙎🏻\u200d♂️", "man pouting: light skin tone", "People & Body", "4.0"},
+	{"🙎🏼\u200d♂️", "man pouting: medium-light skin tone", "People & Body", "4.0"},
+	{"🙎🏽\u200d♂️", "man pouting: medium skin tone", "People & Body", "4.0"},
+	{"🙎🏾\u200d♂️", "man pouting: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙎🏿\u200d♂️", "man pouting: dark skin tone", "People & Body", "4.0"},
+	{"🙎\u200d♀️", "woman pouting", "People & Body", "4.0"},
+	{"🙎🏻\u200d♀️", "woman pouting: light skin tone", "People & Body", "4.0"},
+	{"🙎🏼\u200d♀️", "woman pouting: medium-light skin tone", "People & Body", "4.0"},
+	{"🙎🏽\u200d♀️", "woman pouting: medium skin tone", "People & Body", "4.0"},
+	{"🙎🏾\u200d♀️", "woman pouting: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙎🏿\u200d♀️", "woman pouting: dark skin tone", "People & Body", "4.0"},
+	{"🙅", "person gesturing NO", "People & Body", "0.6"},
+	{"🙅🏻", "person gesturing NO: light skin tone", "People & Body", "1.0"},
+	{"🙅🏼", "person gesturing NO: medium-light skin tone", "People & Body", "1.0"},
+	{"🙅🏽", "person gesturing NO: medium skin tone", "People & Body", "1.0"},
+	{"🙅🏾", "person gesturing NO: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙅🏿", "person gesturing NO: dark skin tone", "People & Body", "1.0"},
+	{"🙅\u200d♂️", "man gesturing NO", "People & Body", "4.0"},
+	{"🙅🏻\u200d♂️", "man gesturing NO: light skin tone", "People & Body", "4.0"},
+	{"🙅🏼\u200d♂️", "man gesturing NO: medium-light skin tone", "People & Body", "4.0"},
+	{"🙅🏽\u200d♂️", "man gesturing NO: medium skin tone", "People & Body", "4.0"},
+	{"🙅🏾\u200d♂️", "man gesturing NO: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙅🏿\u200d♂️", "man gesturing NO: dark skin tone", "People & Body", "4.0"},
+	{"🙅\u200d♀️", "woman gesturing NO", "People & Body", "4.0"},
+	{"🙅🏻\u200d♀️", "woman gesturing NO: light skin tone", "People & Body", "4.0"},
+	{"🙅🏼\u200d♀️", "woman gesturing NO: medium-light skin tone", "People & Body", "4.0"},
+	{"🙅🏽\u200d♀️", "woman gesturing NO: medium skin tone", "People & Body", "4.0"},
+	{"🙅🏾\u200d♀️", "woman gesturing NO: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙅🏿\u200d♀️", "woman gesturing NO: dark skin tone", "People & Body", "4.0"},
+	{"🙆", "person gesturing OK", "People & Body", "0.6"},
+	{"🙆🏻", "person gesturing OK: light skin tone", "People & Body", "1.0"},
+	{"🙆🏼", "person gesturing OK: medium-light skin tone", "People & Body", "1.0"},
+	{"🙆🏽", "person gesturing OK: medium skin tone", "People & Body", "1.0"},
+	{"🙆🏾", "person gesturing OK: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙆🏿", "person gesturing OK: dark skin tone", "People & Body", "1.0"},
+	{"🙆\u200d♂️", "man gesturing OK", "People & Body", "4.0"},
+	{"🙆🏻\u200d♂️", "man gesturing OK: light skin tone", "People & Body", "4.0"},
+	{"🙆🏼\u200d♂️", "man gesturing OK: medium-light skin tone", "People & Body", "4.0"},
+	{"🙆🏽\u200d♂️", "man gesturing OK: medium skin tone", "People & Body", "4.0"},
+	{"🙆🏾\u200d♂️", "man gesturing OK: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙆🏿\u200d♂️", "man gesturing OK: dark skin tone", "People & Body", "4.0"},
+	{"🙆\u200d♀️", "woman gesturing OK", "People & Body", "4.0"},
+	{"🙆🏻\u200d♀️", "woman gesturing OK: light skin tone", "People & Body", "4.0"},
+	{"🙆🏼\u200d♀️", "woman gesturing OK: medium-light skin tone", "People & Body", "4.0"},
+	{"🙆🏽\u200d♀️", "woman gesturing OK: medium skin tone", "People & Body", "4.0"},
+	{"🙆🏾\u200d♀️", "woman gesturing OK: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙆🏿\u200d♀️", "woman gesturing OK: dark skin tone", "People & Body", "4.0"},
+	{"💁", "person tipping hand", "People & Body", "0.6"},
+	{"💁🏻", "person tipping hand: light skin tone", "People & Body", "1.0"},
+	{"💁🏼", "person tipping hand: medium-light skin tone", "People & Body", "1.0"},
+	{"💁🏽", "person tipping hand: medium skin tone", "People & Body", "1.0"},
+	{"💁🏾", "person tipping hand: medium-dark skin tone", "People & Body", "1.0"},
+	{"💁🏿", "person tipping hand: dark skin tone", "People & Body", "1.0"},
+	{"💁\u200d♂️", "man tipping hand", "People & Body", "4.0"},
+	{"💁🏻\u200d♂️", "man tipping hand: light skin tone", "People & Body", "4.0"},
+	{"💁🏼\u200d♂️", "man tipping hand: medium-light skin tone", "People & Body", "4.0"},
+	{"💁🏽\u200d♂️", "man tipping hand: medium skin tone", "People & Body", "4.0"},
+	{"💁🏾\u200d♂️", "man tipping hand: medium-dark skin tone", "People & Body", "4.0"},
+	{"💁🏿\u200d♂️", "man tipping hand: dark skin tone", "People & Body", "4.0"},
+	{"💁\u200d♀️", "woman tipping hand", "People & Body", "4.0"},
+	{"💁🏻\u200d♀️", "woman tipping hand: light skin tone", "People & Body", "4.0"},
+	{"💁🏼\u200d♀️", "woman tipping hand: medium-light skin tone", "People & Body", "4.0"},
+	{"💁🏽\u200d♀️", "woman tipping hand: medium skin tone", "People & Body", "4.0"},
+	{"💁🏾\u200d♀️", "woman tipping hand: medium-dark skin tone", "People & Body", "4.0"},
+	{"💁🏿\u200d♀️", "woman tipping hand: dark skin tone", "People & Body", "4.0"},
+	{"🙋", "person raising hand", "People & Body", "0.6"},
+	{"🙋🏻", "person raising hand: light skin tone", "People & Body", "1.0"},
+	{"🙋🏼", "person raising hand: medium-light skin tone", "People & Body", "1.0"},
+	{"🙋🏽", "person raising hand: medium skin tone", "People & Body", "1.0"},
+	{"🙋🏾", "person raising hand: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙋🏿", "person raising hand: dark skin tone", "People & Body", "1.0"},
+	{"🙋\u200d♂️", "man raising hand", "People & Body", "4.0"},
+	{"🙋🏻\u200d♂️", "man raising hand: light skin tone", "People & Body", "4.0"},
+	{"🙋🏼\u200d♂️", "man raising hand: medium-light skin tone", "People & Body", "4.0"},
+	{"🙋🏽\u200d♂️", "man raising hand: medium skin tone", "People & Body", "4.0"},
+	{"🙋🏾\u200d♂️", "man raising hand: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙋🏿\u200d♂️", "man raising hand: dark skin tone", "People & Body", "4.0"},
+	{"🙋\u200d♀️", "woman raising hand", "People & Body", "4.0"},
+	{"🙋🏻\u200d♀️", "woman raising hand: light skin tone", "People & Body", "4.0"},
+	{"🙋🏼\u200d♀️", "woman raising hand: medium-light skin tone", "People & Body", "4.0"},
+	{"🙋🏽\u200d♀️", "woman raising hand: medium skin tone", "People & Body", "4.0"},
+	{"🙋🏾\u200d♀️", "woman raising hand: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙋🏿\u200d♀️", "woman raising hand: dark skin tone", "People & Body", "4.0"},
+	{"🧏", "deaf person", "People & Body", "12.0"},
+	{"🧏🏻", "deaf person: light skin tone", "People & Body", "12.0"},
+	{"🧏🏼", "deaf person: medium-light skin tone", "People & Body", "12.0"},
+	{"🧏🏽", "deaf person: medium skin tone", "People & Body", "12.0"},
+	{"🧏🏾", "deaf person: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧏🏿", "deaf person: dark skin tone", "People & Body", "12.0"},
+	{"🧏\u200d♂️", "deaf man", "People & Body", "12.0"},
+	{"🧏🏻\u200d♂️", "deaf man: light skin tone", "People & Body", "12.0"},
+	{"🧏🏼\u200d♂️", "deaf man: medium-light skin tone", "People & Body", "12.0"},
+	{"🧏🏽\u200d♂️", "deaf man: medium skin tone", "People & Body", "12.0"},
+	{"🧏🏾\u200d♂️", "deaf man: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧏🏿\u200d♂️", "deaf man: dark skin tone", "People & Body", "12.0"},
+	{"🧏\u200d♀️", "deaf woman", "People & Body", "12.0"},
+	{"🧏🏻\u200d♀️", "deaf woman: light skin tone", "People & Body", "12.0"},
+	{"🧏🏼\u200d♀️", "deaf woman: medium-light skin tone", "People & Body", "12.0"},
+	{"🧏🏽\u200d♀️", "deaf woman: medium skin tone", "People & Body", "12.0"},
+	{"🧏🏾\u200d♀️", "deaf woman: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧏🏿\u200d♀️", "deaf woman: dark skin tone", "People & Body", "12.0"},
+	{"🙇", "person bowing", "People & Body", "0.6"},
+	{"🙇🏻", "person bowing: light skin tone", "People & Body", "1.0"},
+	{"🙇🏼", "person bowing: medium-light skin tone", "People & Body", "1.0"},
+	{"🙇🏽", "person bowing: medium skin tone", "People & Body", "1.0"},
+	{"🙇🏾", "person bowing: medium-dark skin tone", "People & Body", "1.0"},
+	{"🙇🏿", "person bowing: dark skin tone", "People & Body", "1.0"},
+	{"🙇\u200d♂️", "man bowing", "People & Body", "4.0"},
+	{"🙇🏻\u200d♂️", "man bowing: light skin tone", "People & Body", "4.0"},
+	{"🙇🏼\u200d♂️", "man bowing: medium-light skin tone", "People & Body", "4.0"},
+	{"🙇🏽\u200d♂️", "man bowing: medium skin tone", "People & Body", "4.0"},
+	{"🙇🏾\u200d♂️", "man bowing: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙇🏿\u200d♂️", "man bowing: dark skin tone", "People & Body", "4.0"},
+	{"🙇\u200d♀️", "woman bowing", "People & Body", "4.0"},
+	{"🙇🏻\u200d♀️", "woman bowing: light skin tone", "People & Body", "4.0"},
+	{"🙇🏼\u200d♀️", "woman bowing: medium-light skin tone", "People & Body", "4.0"},
+	{"🙇🏽\u200d♀️", "woman bowing: medium skin tone", "People & Body", "4.0"},
+	{"🙇🏾\u200d♀️", "woman bowing: medium-dark skin tone", "People & Body", "4.0"},
+	{"🙇🏿\u200d♀️", "woman bowing: dark skin tone", "People & Body", "4.0"},
+	{"🤦", "person facepalming", "People & Body", "3.0"},
+	{"🤦🏻", "person facepalming: light skin tone", "People & Body", "3.0"},
+	{"🤦🏼", "person facepalming: medium-light skin tone", "People & Body", "3.0"},
+	{"🤦🏽", "person facepalming: medium skin tone", "People & Body", "3.0"},
+	{"🤦🏾", "person facepalming: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤦🏿", "person facepalming: dark skin tone", "People & Body", "3.0"},
+	{"🤦\u200d♂️", "man facepalming", "People & Body", "4.0"},
+	{"🤦🏻\u200d♂️", "man facepalming: light skin tone", "People & Body", "4.0"},
+	{"🤦🏼\u200d♂️", "man facepalming: medium-light skin tone", "People & Body", "4.0"},
+	{"🤦🏽\u200d♂️", "man facepalming: medium skin tone", "People & Body", "4.0"},
+	{"🤦🏾\u200d♂️", "man facepalming: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤦🏿\u200d♂️", "man facepalming: dark skin tone", "People & Body", "4.0"},
+	{"🤦\u200d♀️", "woman facepalming", "People & Body", "4.0"},
+	{"🤦🏻\u200d♀️", "woman facepalming: light skin tone", "People & Body", "4.0"},
+	{"🤦🏼\u200d♀️", "woman facepalming: medium-light skin tone", "People & Body", "4.0"},
+	{"🤦🏽\u200d♀️", "woman facepalming: medium skin tone", "People & Body", "4.0"},
+	{"🤦🏾\u200d♀️", "woman facepalming: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤦🏿\u200d♀️", "woman facepalming: dark skin tone", "People & Body", "4.0"},
+	{"🤷", "person shrugging", "People & Body", "3.0"},
+	{"🤷🏻", "person shrugging: light skin tone", "People & Body", "3.0"},
+	{"🤷🏼", "person shrugging: medium-light skin tone", "People & Body", "3.0"},
+	{"🤷🏽", "person shrugging: medium skin tone", "People & Body", "3.0"},
+	{"🤷🏾", "person shrugging: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤷🏿", "person shrugging: dark skin tone", "People & Body", "3.0"},
+	{"🤷\u200d♂️", "man shrugging", "People & Body", "4.0"},
+	{"🤷🏻\u200d♂️", "man shrugging: light skin tone", "People & Body", "4.0"},
+	{"🤷🏼\u200d♂️", "man shrugging: medium-light skin tone", "People & Body", "4.0"},
+	{"🤷🏽\u200d♂️", "man shrugging: medium skin tone", "People & Body", "4.0"},
+	{"🤷🏾\u200d♂️", "man shrugging: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤷🏿\u200d♂️", "man shrugging: dark skin tone", "People & Body", "4.0"},
+	{"🤷\u200d♀️", "woman shrugging", "People & Body", "4.0"},
+	{"🤷🏻\u200d♀️", "woman shrugging: light skin tone", "People & Body", "4.0"},
+	{"🤷🏼\u200d♀️", "woman shrugging: medium-light skin tone", "People & Body", "4.0"},
+	{"🤷🏽\u200d♀️", "woman shrugging: medium skin tone", "People & Body", "4.0"},
+	{"🤷🏾\u200d♀️", "woman shrugging: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤷🏿\u200d♀️", "woman shrugging: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d⚕️", "health worker", "People & Body", "12.1"},
+	{"🧑🏻\u200d⚕️", "health worker: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d⚕️", "health worker: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d⚕️", "health worker: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d⚕️", "health worker: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d⚕️", "health worker: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d⚕️", "man health worker", "People & Body", "4.0"},
+	{"👨🏻\u200d⚕️", "man health worker: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d⚕️", "man health worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d⚕️", "man health worker: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d⚕️", "man health worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d⚕️", "man health worker: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d⚕️", "woman health worker", "People & Body", "4.0"},
+	{"👩🏻\u200d⚕️", "woman health worker: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d⚕️", "woman health worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d⚕️", "woman health worker: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d⚕️", "woman health worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d⚕️", "woman health worker: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🎓", "student", "People & Body", "12.1"},
+	{"🧑🏻\u200d🎓", "student: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🎓", "student: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🎓", "student: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🎓", "student: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🎓", "student: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🎓", "man student", "People & Body", "4.0"},
+	{"👨🏻\u200d🎓", "man student: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🎓", "man student: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🎓", "man student: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🎓", "man student: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🎓", "man student: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🎓", "woman student", "People & Body", "4.0"},
+	{"👩🏻\u200d🎓", "woman student: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🎓", "woman student: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🎓", "woman student: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🎓", "woman student: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🎓", "woman student: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🏫", "teacher", "People & Body", "12.1"},
+	{"🧑🏻\u200d🏫", "teacher: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🏫", "teacher: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🏫", "teacher: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🏫", "teacher: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🏫", "teacher: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🏫", "man teacher", "People & Body", "4.0"},
+	{"👨🏻\u200d🏫", "man teacher: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🏫", "man teacher: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🏫", "man teacher: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🏫", "man teacher: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🏫", "man teacher: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🏫", "woman teacher", "People & Body", "4.0"},
+	{"👩🏻\u200d🏫", "woman teacher: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🏫", "woman teacher: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🏫", "woman teacher: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🏫", "woman teacher: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🏫", "woman teacher: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d⚖️", "judge", "People & Body", "12.1"},
+	{"🧑🏻\u200d⚖️", "judge: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d⚖️", "judge: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d⚖️", "judge: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d⚖️", "judge: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d⚖️", "judge: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d⚖️", "man judge", "People & Body", "4.0"},
+	{"👨🏻\u200d⚖️", "man judge: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d⚖️", "man judge: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d⚖️", "man judge: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d⚖️", "man judge: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d⚖️", "man judge: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d⚖️", "woman judge", "People & Body", "4.0"},
+	{"👩🏻\u200d⚖️", "woman judge: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d⚖️", "woman judge: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d⚖️", "woman judge: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d⚖️", "woman judge: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d⚖️", "woman judge: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🌾", "farmer", "People & Body", "12.1"},
+	{"🧑🏻\u200d🌾", "farmer: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🌾", "farmer: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🌾", "farmer: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🌾", "farmer: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🌾", "farmer: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🌾", "man farmer", "People & Body", "4.0"},
+	{"👨🏻\u200d🌾", "man farmer: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🌾", "man farmer: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🌾", "man farmer: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🌾", "man farmer: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🌾", "man farmer: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🌾", "woman farmer", "People & Body", "4.0"},
+	{"👩🏻\u200d🌾", "woman farmer: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🌾", "woman farmer: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🌾", "woman farmer: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🌾", "woman farmer: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🌾", "woman farmer: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🍳", "cook", "People & Body", "12.1"},
+	{"🧑🏻\u200d🍳", "cook: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🍳", "cook: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🍳", "cook: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🍳", "cook: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🍳", "cook: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🍳", "man cook", "People & Body", "4.0"},
+	{"👨🏻\u200d🍳", "man cook: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🍳", "man cook: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🍳", "man cook: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🍳", "man cook: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🍳", "man cook: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🍳", "woman cook", "People & Body", "4.0"},
+	{"👩🏻\u200d🍳", "woman cook: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🍳", "woman cook: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🍳", "woman cook: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🍳", "woman cook: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🍳", "woman cook: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🔧", "mechanic", "People & Body", "12.1"},
+	{"🧑🏻\u200d🔧", "mechanic: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🔧", "mechanic: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🔧", "mechanic: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🔧", "mechanic: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🔧", "mechanic: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🔧", "man mechanic", "People & Body", "4.0"},
+	{"👨🏻\u200d🔧", "man mechanic: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🔧", "man mechanic: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🔧", "man mechanic: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🔧", "man mechanic: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🔧", "man mechanic: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🔧", "woman mechanic", "People & Body", "4.0"},
+	{"👩🏻\u200d🔧", "woman mechanic: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🔧", "woman mechanic: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🔧", "woman mechanic: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🔧", "woman mechanic: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🔧", "woman mechanic: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🏭", "factory worker", "People & Body", "12.1"},
+	{"🧑🏻\u200d🏭", "factory worker: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🏭", "factory worker: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🏭", "factory worker: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🏭", "factory worker: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🏭", "factory worker: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🏭", "man factory worker", "People & Body", "4.0"},
+	{"👨🏻\u200d🏭", "man factory worker: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🏭", "man factory worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🏭", "man factory worker: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🏭", "man factory worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🏭", "man factory worker: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🏭", "woman factory worker", "People & Body", "4.0"},
+	{"👩🏻\u200d🏭", "woman factory worker: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🏭", "woman factory worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🏭", "woman factory worker: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🏭", "woman factory worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🏭", "woman factory worker: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d💼", "office worker", "People & Body", "12.1"},
+	{"🧑🏻\u200d💼", "office worker: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d💼", "office worker: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d💼", "office worker: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d💼", "office worker: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d💼", "office worker: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d💼", "man office worker", "People & Body", "4.0"},
+	{"👨🏻\u200d💼", "man office worker: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d💼", "man office worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d💼", "man office worker: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d💼", "man office worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d💼", "man office worker: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d💼", "woman office worker", "People & Body", "4.0"},
+	{"👩🏻\u200d💼", "woman office worker: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d💼", "woman office worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d💼", "woman office worker: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d💼", "woman office worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d💼", "woman office worker: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🔬", "scientist", "People & Body", "12.1"},
+	{"🧑🏻\u200d🔬", "scientist: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🔬", "scientist: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🔬", "scientist: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🔬", "scientist: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🔬", "scientist: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🔬", "man scientist", "People & Body", "4.0"},
+	{"👨🏻\u200d🔬", "man scientist: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🔬", "man scientist: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🔬", "man scientist: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🔬", "man scientist: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🔬", "man scientist: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🔬", "woman scientist", "People & Body", "4.0"},
+	{"👩🏻\u200d🔬", "woman scientist: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🔬", "woman scientist: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🔬", "woman scientist: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🔬", "woman scientist: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🔬", "woman scientist: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d💻", "technologist", "People & Body", "12.1"},
+	{"🧑🏻\u200d💻", "technologist: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d💻", "technologist: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d💻", "technologist: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d💻", "technologist: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d💻", "technologist: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d💻", "man technologist", "People & Body", "4.0"},
+	{"👨🏻\u200d💻", "man technologist: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d💻", "man technologist: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d💻", "man technologist: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d💻", "man technologist: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d💻", "man technologist: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d💻", "woman technologist", "People & Body", "4.0"},
+	{"👩🏻\u200d💻", "woman technologist: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d💻", "woman technologist: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d💻", "woman technologist: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d💻", "woman technologist: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d💻", "woman technologist: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🎤", "singer", "People & Body", "12.1"},
+	{"🧑🏻\u200d🎤", "singer: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🎤", "singer: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🎤", "singer: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🎤", "singer: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🎤", "singer: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🎤", "man singer", "People & Body", "4.0"},
+	{"👨🏻\u200d🎤", "man singer: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🎤", "man singer: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🎤", "man singer: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🎤", "man singer: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🎤", "man singer: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🎤", "woman singer", "People & Body", "4.0"},
+	{"👩🏻\u200d🎤", "woman singer: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🎤", "woman singer: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🎤", "woman singer: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🎤", "woman singer: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🎤", "woman singer: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🎨", "artist", "People & Body", "12.1"},
+	{"🧑🏻\u200d🎨", "artist: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🎨", "artist: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🎨", "artist: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🎨", "artist: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🎨", "artist: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🎨", "man artist", "People & Body", "4.0"},
+	{"👨🏻\u200d🎨", "man artist: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🎨", "man artist: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🎨", "man artist: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🎨", "man artist: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🎨", "man artist: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🎨", "woman artist", "People & Body", "4.0"},
+	{"👩🏻\u200d🎨", "woman artist: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🎨", "woman artist: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🎨", "woman artist: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🎨", "woman artist: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🎨", "woman artist: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d✈️", "pilot", "People & Body", "12.1"},
+	{"🧑🏻\u200d✈️", "pilot: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d✈️", "pilot: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d✈️", "pilot: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d✈️", "pilot: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d✈️", "pilot: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d✈️", "man pilot", "People & Body", "4.0"},
+	{"👨🏻\u200d✈️", "man pilot: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d✈️", "man pilot: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d✈️", "man pilot: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d✈️", "man pilot: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d✈️", "man pilot: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d✈️", "woman pilot", "People & Body", "4.0"},
+	{"👩🏻\u200d✈️", "woman pilot: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d✈️", "woman pilot: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d✈️", "woman pilot: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d✈️", "woman pilot: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d✈️", "woman pilot: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🚀", "astronaut", "People & Body", "12.1"},
+	{"🧑🏻\u200d🚀", "astronaut: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🚀", "astronaut: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🚀", "astronaut: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🚀", "astronaut: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🚀", "astronaut: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🚀", "man astronaut", "People & Body", "4.0"},
+	{"👨🏻\u200d🚀", "man astronaut: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🚀", "man astronaut: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🚀", "man astronaut: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🚀", "man astronaut: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🚀", "man astronaut: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🚀", "woman astronaut", "People & Body", "4.0"},
+	{"👩🏻\u200d🚀", "woman astronaut: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🚀", "woman astronaut: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🚀", "woman astronaut: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🚀", "woman astronaut: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🚀", "woman astronaut: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🚒", "firefighter", "People & Body", "12.1"},
+	{"🧑🏻\u200d🚒", "firefighter: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🚒", "firefighter: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🚒", "firefighter: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🚒", "firefighter: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🚒", "firefighter: dark skin tone", "People & Body", "12.1"},
+	{"👨\u200d🚒", "man firefighter", "People & Body", "4.0"},
+	{"👨🏻\u200d🚒", "man firefighter: light skin tone", "People & Body", "4.0"},
+	{"👨🏼\u200d🚒", "man firefighter: medium-light skin tone", "People & Body", "4.0"},
+	{"👨🏽\u200d🚒", "man firefighter: medium skin tone", "People & Body", "4.0"},
+	{"👨🏾\u200d🚒", "man firefighter: medium-dark skin tone", "People & Body", "4.0"},
+	{"👨🏿\u200d🚒", "man firefighter: dark skin tone", "People & Body", "4.0"},
+	{"👩\u200d🚒", "woman firefighter", "People & Body", "4.0"},
+	{"👩🏻\u200d🚒", "woman firefighter: light skin tone", "People & Body", "4.0"},
+	{"👩🏼\u200d🚒", "woman firefighter: medium-light skin tone", "People & Body", "4.0"},
+	{"👩🏽\u200d🚒", "woman firefighter: medium skin tone", "People & Body", "4.0"},
+	{"👩🏾\u200d🚒", "woman firefighter: medium-dark skin tone", "People & Body", "4.0"},
+	{"👩🏿\u200d🚒", "woman firefighter: dark skin tone", "People & Body", "4.0"},
+	{"👮", "police officer", "People & Body", "0.6"},
+	{"👮🏻", "police officer: light skin tone", "People & Body", "1.0"},
+	{"👮🏼", "police officer: medium-light skin tone", "People & Body", "1.0"},
+	{"👮🏽", "police officer: medium skin tone", "People & Body", "1.0"},
+	{"👮🏾", "police officer: medium-dark skin tone", "People & Body", "1.0"},
+	{"👮🏿", "police officer: dark skin tone", "People & Body", "1.0"},
+	{"👮\u200d♂️", "man police officer", "People & Body", "4.0"},
+	{"👮🏻\u200d♂️", "man police officer: light skin tone", "People & Body", "4.0"},
+	{"👮🏼\u200d♂️", "man police officer: medium-light skin tone", "People & Body", "4.0"},
+	{"👮🏽\u200d♂️", "man police officer: medium skin tone", "People & Body", "4.0"},
+	{"👮🏾\u200d♂️", "man police officer: medium-dark skin tone", "People & Body", "4.0"},
+	{"👮🏿\u200d♂️", "man police officer: dark skin tone", "People & Body", "4.0"},
+	{"👮\u200d♀️", "woman police officer", "People & Body", "4.0"},
+	{"👮🏻\u200d♀️", "woman police officer: light skin tone", "People & Body", "4.0"},
+	{"👮🏼\u200d♀️", "woman police officer: medium-light skin tone", "People & Body", "4.0"},
+	{"👮🏽\u200d♀️", "woman police officer: medium skin tone", "People & Body", "4.0"},
+	{"👮🏾\u200d♀️", "woman police officer: medium-dark skin tone", "People & Body", "4.0"},
+	{"👮🏿\u200d♀️", "woman police officer: dark skin tone", "People & Body", "4.0"},
+	{"🕵️", "detective", "People & Body", "0.7"},
+	{"🕵🏻", "detective: light skin tone", "People & Body", "2.0"},
+	{"🕵🏼", "detective: medium-light skin tone", "People & Body", "2.0"},
+	{"🕵🏽", "detective: medium skin tone", "People & Body", "2.0"},
+	{"🕵🏾", "detective: medium-dark skin tone", "People & Body", "2.0"},
+	{"🕵🏿", "detective: dark skin tone", "People & Body", "2.0"},
+	{"🕵️\u200d♂️", "man detective", "People & Body", "4.0"},
+	{"🕵🏻\u200d♂️", "man detective: light skin tone", "People & Body", "4.0"},
+	{"🕵🏼\u200d♂️", "man detective: medium-light skin tone", "People & Body", "4.0"},
+	{"🕵🏽\u200d♂️", "man detective: medium skin tone", "People & Body", "4.0"},
+	{"🕵🏾\u200d♂️", "man detective: medium-dark skin tone", "People & Body", "4.0"},
+	{"🕵🏿\u200d♂️", "man detective: dark skin tone", "People & Body", "4.0"},
+	{"🕵️\u200d♀️", "woman detective", "People & Body", "4.0"},
+	{"🕵🏻\u200d♀️", "woman detective: light skin tone", "People & Body", "4.0"},
+	{"🕵🏼\u200d♀️", "woman detective: medium-light skin tone", "People & Body", "4.0"},
+	{"🕵🏽\u200d♀️", "woman detective: medium skin tone", "People & Body", "4.0"},
+	{"🕵🏾\u200d♀️", "woman detective: medium-dark skin tone", "People & Body", "4.0"},
+	{"🕵🏿\u200d♀️", "woman detective: dark skin tone", "People & Body", "4.0"},
+	{"💂", "guard", "People & Body", "0.6"},
+	{"💂🏻", "guard: light skin tone", "People & Body", "1.0"},
+	{"💂🏼", "guard: medium-light skin tone", "People & Body", "1.0"},
+	{"💂🏽", "guard: medium skin tone", "People & Body", "1.0"},
+	{"💂🏾", "guard: medium-dark skin tone", "People & Body", "1.0"},
+	{"💂🏿", "guard: dark skin tone", "People & Body", "1.0"},
+	{"💂\u200d♂️", "man guard", "People & Body", "4.0"},
+	{"💂🏻\u200d♂️", "man guard: light skin tone", "People & Body", "4.0"},
+	{"💂🏼\u200d♂️", "man guard: medium-light skin tone", "People & Body", "4.0"},
+	{"💂🏽\u200d♂️", "man guard: medium skin tone", "People & Body", "4.0"},
+	{"💂🏾\u200d♂️", "man guard: medium-dark skin tone", "People & Body", "4.0"},
+	{"💂🏿\u200d♂️", "man guard: dark skin tone", "People & Body", "4.0"},
+	{"💂\u200d♀️", "woman guard", "People & Body", "4.0"},
+	{"💂🏻\u200d♀️", "woman guard: light skin tone", "People & Body", "4.0"},
+	{"💂🏼\u200d♀️", "woman guard: medium-light skin tone", "People & Body", "4.0"},
+	{"💂🏽\u200d♀️", "woman guard: medium skin tone", "People & Body", "4.0"},
+	{"💂🏾\u200d♀️", "woman guard: medium-dark skin tone", "People & Body", "4.0"},
+	{"💂🏿\u200d♀️", "woman guard: dark skin tone", "People & Body", "4.0"},
+	{"🥷", "ninja", "People & Body", "13.0"},
+	{"🥷🏻", "ninja: light skin tone", "People & Body", "13.0"},
+	{"🥷🏼", "ninja: medium-light skin tone", "People & Body", "13.0"},
+	{"🥷🏽", "ninja: medium skin tone", "People & Body", "13.0"},
+	{"🥷🏾", "ninja: medium-dark skin tone", "People & Body", "13.0"},
+	{"🥷🏿", "ninja: dark skin tone", "People & Body", "13.0"},
+	{"👷", "construction worker", "People & Body", "0.6"},
+	{"👷🏻", "construction worker: light skin tone", "People & Body", "1.0"},
+	{"👷🏼", "construction worker: medium-light skin tone", "People & Body", "1.0"},
+	{"👷🏽", "construction worker: medium skin tone", "People & Body", "1.0"},
+	{"👷🏾", "construction worker: medium-dark skin tone", "People & Body", "1.0"},
+	{"👷🏿", "construction worker: dark skin tone", "People & Body", "1.0"},
+	{"👷\u200d♂️", "man construction worker", "People & Body", "4.0"},
+	{"👷🏻\u200d♂️", "man construction worker: light skin tone", "People & Body", "4.0"},
+	{"👷🏼\u200d♂️", "man construction worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👷🏽\u200d♂️", "man construction worker: medium skin tone", "People & Body", "4.0"},
+	{"👷🏾\u200d♂️", "man construction worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👷🏿\u200d♂️", "man construction worker: dark skin tone", "People & Body", "4.0"},
+	{"👷\u200d♀️", "woman construction worker", "People & Body", "4.0"},
+	{"👷🏻\u200d♀️", "woman construction worker: light skin tone", "People & Body", "4.0"},
+	{"👷🏼\u200d♀️", "woman construction worker: medium-light skin tone", "People & Body", "4.0"},
+	{"👷🏽\u200d♀️", "woman construction worker: medium skin tone", "People & Body", "4.0"},
+	{"👷🏾\u200d♀️", "woman construction worker: medium-dark skin tone", "People & Body", "4.0"},
+	{"👷🏿\u200d♀️", "woman construction worker: dark skin tone", "People & Body", "4.0"},
+	{"🫅", "person with crown", "People & Body", "14.0"},
+	{"🫅🏻", "person with crown: light skin tone", "People & Body", "14.0"},
+	{"🫅🏼", "person with crown: medium-light skin tone", "People & Body", "14.0"},
+	{"🫅🏽", "person with crown: medium skin tone", "People & Body", "14.0"},
+	{"🫅🏾", "person with crown: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫅🏿", "person with crown: dark skin tone", "People & Body", "14.0"},
+	{"🤴", "prince", "People & Body", "3.0"},
+	{"🤴🏻", "prince: light skin tone", "People & Body", "3.0"},
+	{"🤴🏼", "prince: medium-light skin tone", "People & Body", "3.0"},
+	{"🤴🏽", "prince: medium skin tone", "People & Body", "3.0"},
+	{"🤴🏾", "prince: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤴🏿", "prince: dark skin tone", "People & Body", "3.0"},
+	{"👸", "princess", "People & Body", "0.6"},
+	{"👸🏻", "princess: light skin tone", "People & Body", "1.0"},
+	{"👸🏼", "princess: medium-light skin tone", "People & Body", "1.0"},
+	{"👸🏽", "princess: medium skin tone", "People & Body", "1.0"},
+	{"👸🏾", "princess: medium-dark skin tone", "People & Body", "1.0"},
+	{"👸🏿", "princess: dark skin tone", "People & Body", "1.0"},
+	{"👳", "person wearing turban", "People & Body", "0.6"},
+	{"👳🏻", "person wearing turban: light skin tone", "People & Body", "1.0"},
+	{"👳🏼", "person wearing turban: medium-light skin tone", "People & Body", "1.0"},
+	{"👳🏽", "person wearing turban: medium skin tone", "People & Body", "1.0"},
+	{"👳🏾", "person wearing turban: medium-dark skin tone", "People & Body", "1.0"},
+	{"👳🏿", "person wearing turban: dark skin tone", "People & Body", "1.0"},
+	{"👳\u200d♂️", "man wearing turban", "People & Body", "4.0"},
+	{"👳🏻\u200d♂️", "man wearing turban: light skin tone", "People & Body", "4.0"},
+	{"👳🏼\u200d♂️", "man wearing turban: medium-light skin tone", "People & Body", "4.0"},
+	{"👳🏽\u200d♂️", "man wearing turban: medium skin tone", "People & Body", "4.0"},
+	{"👳🏾\u200d♂️", "man wearing turban: medium-dark skin tone", "People & Body", "4.0"},
+	{"👳🏿\u200d♂️", "man wearing turban: dark skin tone", "People & Body", "4.0"},
+	{"👳\u200d♀️", "woman wearing turban", "People & Body", "4.0"},
+	{"👳🏻\u200d♀️", "woman wearing turban: light skin tone", "People & Body", "4.0"},
+	{"👳🏼\u200d♀️", "woman wearing turban: medium-light skin tone", "People & Body", "4.0"},
+	{"👳🏽\u200d♀️", "woman wearing turban: medium skin tone", "People & Body", "4.0"},
+	{"👳🏾\u200d♀️", "woman wearing turban: medium-dark skin tone", "People & Body", "4.0"},
+	{"👳🏿\u200d♀️", "woman wearing turban: dark skin tone", "People & Body", "4.0"},
+	{"👲", "person with skullcap", "People & Body", "0.6"},
+	{"👲🏻", "person with skullcap: light skin tone", "People & Body", "1.0"},
+	{"👲🏼", "person with skullcap: medium-light skin tone", "People & Body", "1.0"},
+	{"👲🏽", "person with skullcap: medium skin tone", "People & Body", "1.0"},
+	{"👲🏾", "person with skullcap: medium-dark skin tone", "People & Body", "1.0"},
+	{"👲🏿", "person with skullcap: dark skin tone", "People & Body", "1.0"},
+	{"🧕", "woman with headscarf", "People & Body", "5.0"},
+	{"🧕🏻", "woman with headscarf: light skin tone", "People & Body", "5.0"},
+	{"🧕🏼", "woman with headscarf: medium-light skin tone", "People & Body", "5.0"},
+	{"🧕🏽", "woman with headscarf: medium skin tone", "People & Body", "5.0"},
+	{"🧕🏾", "woman with headscarf: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧕🏿", "woman with headscarf: dark skin tone", "People & Body", "5.0"},
+	{"🤵", "person in tuxedo", "People & Body", "3.0"},
+	{"🤵🏻", "person in tuxedo: light skin tone", "People & Body", "3.0"},
+	{"🤵🏼", "person in tuxedo: medium-light skin tone", "People & Body", "3.0"},
+	{"🤵🏽", "person in tuxedo: medium skin tone", "People & Body", "3.0"},
+	{"🤵🏾", "person in tuxedo: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤵🏿", "person in tuxedo: dark skin tone", "People & Body", "3.0"},
+	{"🤵\u200d♂️", "man in tuxedo", "People & Body", "13.0"},
+	{"🤵🏻\u200d♂️", "man in tuxedo: light skin tone", "People & Body", "13.0"},
+	{"🤵🏼\u200d♂️", "man in tuxedo: medium-light skin tone", "People & Body", "13.0"},
+	{"🤵🏽\u200d♂️", "man in tuxedo: medium skin tone", "People & Body", "13.0"},
+	{"🤵🏾\u200d♂️", "man in tuxedo: medium-dark skin tone", "People & Body", "13.0"},
+	{"🤵🏿\u200d♂️", "man in tuxedo: dark skin tone", "People & Body", "13.0"},
+	{"🤵\u200d♀️", "woman in tuxedo", "People & Body", "13.0"},
+	{"🤵🏻\u200d♀️", "woman in tuxedo: light skin tone", "People & Body", "13.0"},
+	{"🤵🏼\u200d♀️", "woman in tuxedo: medium-light skin tone", "People & Body", "13.0"},
+	{"🤵🏽\u200d♀️", "woman in tuxedo: medium skin tone", "People & Body", "13.0"},
+	{"🤵🏾\u200d♀️", "woman in tuxedo: medium-dark skin tone", "People & Body", "13.0"},
+	{"🤵🏿\u200d♀️", "woman in tuxedo: dark skin tone", "People & Body", "13.0"},
+	{"👰", "person with veil", "People & Body", "0.6"},
+	{"👰🏻", "person with veil: light skin tone", "People & Body", "1.0"},
+	{"👰🏼", "person with veil: medium-light skin tone", "People & Body", "1.0"},
+	{"👰🏽", "person with veil: medium skin tone", "People & Body", "1.0"},
+	{"👰🏾", "person with veil: medium-dark skin tone", "People & Body", "1.0"},
+	{"👰🏿", "person with veil: dark skin tone", "People & Body", "1.0"},
+	{"👰\u200d♂️", "man with veil", "People & Body", "13.0"},
+	{"👰🏻\u200d♂️", "man with veil: light skin tone", "People & Body", "13.0"},
+	{"👰🏼\u200d♂️", "man with veil: medium-light skin tone", "People & Body", "13.0"},
+	{"👰🏽\u200d♂️", "man with veil: medium skin tone", "People & Body", "13.0"},
+	{"👰🏾\u200d♂️", "man with veil: medium-dark skin tone", "People & Body", "13.0"},
+	{"👰🏿\u200d♂️", "man with veil: dark skin tone", "People & Body", "13.0"},
+	{"👰\u200d♀️", "woman with veil", "People & Body", "13.0"},
+	{"👰🏻\u200d♀️", "woman with veil: light skin tone", "People & Body", "13.0"},
+	{"👰🏼\u200d♀️", "woman with veil: medium-light skin tone", "People & Body", "13.0"},
+	{"👰🏽\u200d♀️", "woman with veil: medium skin tone", "People & Body", "13.0"},
+	{"👰🏾\u200d♀️", "woman with veil: medium-dark skin tone", "People & Body", "13.0"},
+	{"👰🏿\u200d♀️", "woman with veil: dark skin tone", "People & Body", "13.0"},
+	{"🤰", "pregnant woman", "People & Body", "3.0"},
+	{"🤰🏻", "pregnant woman: light skin tone", "People & Body", "3.0"},
+	{"🤰🏼", "pregnant woman: medium-light skin tone", "People & Body", "3.0"},
+	{"🤰🏽", "pregnant woman: medium skin tone", "People & Body", "3.0"},
+	{"🤰🏾", "pregnant woman: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤰🏿", "pregnant woman: dark skin tone", "People & Body", "3.0"},
+	{"🫃", "pregnant man", "People & Body", "14.0"},
+	{"🫃🏻", "pregnant man: light skin tone", "People & Body", "14.0"},
+	{"🫃🏼", "pregnant man: medium-light skin tone", "People & Body", "14.0"},
+	{"🫃🏽", "pregnant man: medium skin tone", "People & Body", "14.0"},
+	{"🫃🏾", "pregnant man: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫃🏿", "pregnant man: dark skin tone", "People & Body", "14.0"},
+	{"🫄", "pregnant person", "People & Body", "14.0"},
+	{"🫄🏻", "pregnant person: light skin tone", "People & Body", "14.0"},
+	{"🫄🏼", "pregnant person: medium-light skin tone", "People & Body", "14.0"},
+	{"🫄🏽", "pregnant person: medium skin tone", "People & Body", "14.0"},
+	{"🫄🏾", "pregnant person: medium-dark skin tone", "People & Body", "14.0"},
+	{"🫄🏿", "pregnant person: dark skin tone", "People & Body", "14.0"},
+	{"🤱", "breast-feeding", "People & Body", "5.0"},
+	{"🤱🏻", "breast-feeding: light skin tone", "People & Body", "5.0"},
+	{"🤱🏼", "breast-feeding: medium-light skin tone", "People & Body", "5.0"},
+	{"🤱🏽", "breast-feeding: medium skin tone", "People & Body", "5.0"},
+	{"🤱🏾", "breast-feeding: medium-dark skin tone", "People & Body", "5.0"},
+	{"🤱🏿", "breast-feeding: dark skin tone", "People & Body", "5.0"},
+	{"👩\u200d🍼", "woman feeding baby", "People & Body", "13.0"},
+	{"👩🏻\u200d🍼", "woman feeding baby: light skin tone", "People & Body", "13.0"},
+	{"👩🏼\u200d🍼", "woman feeding baby: medium-light skin tone", "People & Body", "13.0"},
+	{"👩🏽\u200d🍼", "woman feeding baby: medium skin tone", "People & Body", "13.0"},
+	{"👩🏾\u200d🍼", "woman feeding baby: medium-dark skin tone", "People & Body", "13.0"},
+	{"👩🏿\u200d🍼", "woman feeding baby: dark skin tone", "People & Body", "13.0"},
+	{"👨\u200d🍼", "man feeding baby", "People & Body", "13.0"},
+	{"👨🏻\u200d🍼", "man feeding baby: light skin tone", "People & Body", "13.0"},
+	{"👨🏼\u200d🍼", "man feeding baby: medium-light skin tone", "People & Body", "13.0"},
+	{"👨🏽\u200d🍼", "man feeding baby: medium skin tone", "People & Body", "13.0"},
+	{"👨🏾\u200d🍼", "man feeding baby: medium-dark skin tone", "People & Body", "13.0"},
+	{"👨🏿\u200d🍼", "man feeding baby: dark skin tone", "People & Body", "13.0"},
+	{"🧑\u200d🍼", "person feeding baby", "People & Body", "13.0"},
+	{"🧑🏻\u200d🍼", "person feeding baby: light skin tone", "People & Body", "13.0"},
+	{"🧑🏼\u200d🍼", "person feeding baby: medium-light skin tone", "People & Body", "13.0"},
+	{"🧑🏽\u200d🍼", "person feeding baby: medium skin tone", "People & Body", "13.0"},
+	{"🧑🏾\u200d🍼", "person feeding baby: medium-dark skin tone", "People & Body", "13.0"},
+	{"🧑🏿\u200d🍼", "person feeding baby: dark skin tone", "People & Body", "13.0"},
+	{"👼", "baby angel", "People & Body", "0.6"},
+	{"👼🏻", "baby angel: light skin tone", "People & Body", "1.0"},
+	{"👼🏼", "baby angel: medium-light skin tone", "People & Body", "1.0"},
+	{"👼🏽", "baby angel: medium skin tone", "People & Body", "1.0"},
+	{"👼🏾", "baby angel: medium-dark skin tone", "People & Body", "1.0"},
+	{"👼🏿", "baby angel: dark skin tone", "People & Body", "1.0"},
+	{"🎅", "Santa Claus", "People & Body", "0.6"},
+	{"🎅🏻", "Santa Claus: light skin tone", "People & Body", "1.0"},
+	{"🎅🏼", "Santa Claus: medium-light skin tone", "People & Body", "1.0"},
+	{"🎅🏽", "Santa Claus: medium skin tone", "People & Body", "1.0"},
+	{"🎅🏾", "Santa Claus: medium-dark skin tone", "People & Body", "1.0"},
+	{"🎅🏿", "Santa Claus: dark skin tone", "People & Body", "1.0"},
+	{"🤶", "Mrs. Claus", "People & Body", "3.0"},
+	{"🤶🏻", "Mrs. Claus: light skin tone", "People & Body", "3.0"},
+	{"🤶🏼", "Mrs. Claus: medium-light skin tone", "People & Body", "3.0"},
+	{"🤶🏽", "Mrs. Claus: medium skin tone", "People & Body", "3.0"},
+	{"🤶🏾", "Mrs. Claus: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤶🏿", "Mrs. Claus: dark skin tone", "People & Body", "3.0"},
+	{"🧑\u200d🎄", "mx claus", "People & Body", "13.0"},
+	{"🧑🏻\u200d🎄", "mx claus: light skin tone", "People & Body", "13.0"},
+	{"🧑🏼\u200d🎄", "mx claus: medium-light skin tone", "People & Body", "13.0"},
+	{"🧑🏽\u200d🎄", "mx claus: medium skin tone", "People & Body", "13.0"},
+	{"🧑🏾\u200d🎄", "mx claus: medium-dark skin tone", "People & Body", "13.0"},
+	{"🧑🏿\u200d🎄", "mx claus: dark skin tone", "People & Body", "13.0"},
+	{"🦸", "superhero", "People & Body", "11.0"},
+	{"🦸🏻", "superhero: light skin tone", "People & Body", "11.0"},
+	{"🦸🏼", "superhero: medium-light skin tone", "People & Body", "11.0"},
+	{"🦸🏽", "superhero: medium skin tone", "People & Body", "11.0"},
+	{"🦸🏾", "superhero: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦸🏿", "superhero: dark skin tone", "People & Body", "11.0"},
+	{"🦸\u200d♂️", "man superhero", "People & Body", "11.0"},
+	{"🦸🏻\u200d♂️", "man superhero: light skin tone", "People & Body", "11.0"},
+	{"🦸🏼\u200d♂️", "man superhero: medium-light skin tone", "People & Body", "11.0"},
+	{"🦸🏽\u200d♂️", "man superhero: medium skin tone", "People & Body", "11.0"},
+	{"🦸🏾\u200d♂️", "man superhero: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦸🏿\u200d♂️", "man superhero: dark skin tone", "People & Body", "11.0"},
+	{"🦸\u200d♀️", "woman superhero", "People & Body", "11.0"},
+	{"🦸🏻\u200d♀️", "woman superhero: light skin tone", "People & Body", "11.0"},
+	{"🦸🏼\u200d♀️", "woman superhero: medium-light skin tone", "People & Body", "11.0"},
+	{"🦸🏽\u200d♀️", "woman superhero: medium skin tone", "People & Body", "11.0"},
+	{"🦸🏾\u200d♀️", "woman superhero: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦸🏿\u200d♀️", "woman superhero: dark skin tone", "People & Body", "11.0"},
+	{"🦹", "supervillain", "People & Body", "11.0"},
+	{"🦹🏻", "supervillain: light skin tone", "People & Body", "11.0"},
+	{"🦹🏼", "supervillain: medium-light skin tone", "People & Body", "11.0"},
+	{"🦹🏽", "supervillain: medium skin tone", "People & Body", "11.0"},
+	{"🦹🏾", "supervillain: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦹🏿", "supervillain: dark skin tone", "People & Body", "11.0"},
+	{"🦹\u200d♂️", "man supervillain", "People & Body", "11.0"},
+	{"🦹🏻\u200d♂️", "man supervillain: light skin tone", "People & Body", "11.0"},
+	{"🦹🏼\u200d♂️", "man supervillain: medium-light skin tone", "People & Body", "11.0"},
+	{"🦹🏽\u200d♂️", "man supervillain: medium skin tone", "People & Body", "11.0"},
+	{"🦹🏾\u200d♂️", "man supervillain: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦹🏿\u200d♂️", "man supervillain: dark skin tone", "People & Body", "11.0"},
+	{"🦹\u200d♀️", "woman supervillain", "People & Body", "11.0"},
+	{"🦹🏻\u200d♀️", "woman supervillain: light skin tone", "People & Body", "11.0"},
+	{"🦹🏼\u200d♀️", "woman supervillain: medium-light skin tone", "People & Body", "11.0"},
+	{"🦹🏽\u200d♀️", "woman supervillain: medium skin tone", "People & Body", "11.0"},
+	{"🦹🏾\u200d♀️", "woman supervillain: medium-dark skin tone", "People & Body", "11.0"},
+	{"🦹🏿\u200d♀️", "woman supervillain: dark skin tone", "People & Body", "11.0"},
+	{"🧙", "mage", "People & Body", "5.0"},
+	{"🧙🏻", "mage: light skin tone", "People & Body", "5.0"},
+	{"🧙🏼", "mage: medium-light skin tone", "People & Body", "5.0"},
+	{"🧙🏽", "mage: medium skin tone", "People & Body", "5.0"},
+	{"🧙🏾", "mage: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧙🏿", "mage: dark skin tone", "People & Body", "5.0"},
+	{"🧙\u200d♂️", "man mage", "People & Body", "5.0"},
+	{"🧙🏻\u200d♂️", "man mage: light skin tone", "People & Body", "5.0"},
+	{"🧙🏼\u200d♂️", "man mage: medium-light skin tone", "People & Body", "5.0"},
+	{"🧙🏽\u200d♂️", "man mage: medium skin tone", "People & Body", "5.0"},
+	{"🧙🏾\u200d♂️", "man mage: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧙🏿\u200d♂️", "man mage: dark skin tone", "People & Body", "5.0"},
+	{"🧙\u200d♀️", "woman mage", "People & Body", "5.0"},
+	{"🧙🏻\u200d♀️", "woman mage: light skin tone", "People & Body", "5.0"},
+	{"🧙🏼\u200d♀️", "woman mage: medium-light skin tone", "People & Body", "5.0"},
+	{"🧙🏽\u200d♀️", "woman mage: medium skin tone", "People & Body", "5.0"},
+	{"🧙🏾\u200d♀️", "woman mage: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧙🏿\u200d♀️", "woman mage: dark skin tone", "People & Body", "5.0"},
+	{"🧚", "fairy", "People & Body", "5.0"},
+	{"🧚🏻", "fairy: light skin tone", "People & Body", "5.0"},
+	{"🧚🏼", "fairy: medium-light skin tone", "People & Body", "5.0"},
+	{"🧚🏽", "fairy: medium skin tone", "People & Body", "5.0"},
+	{"🧚🏾", "fairy: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧚🏿", "fairy: dark skin tone", "People & Body", "5.0"},
+	{"🧚\u200d♂️", "man fairy", "People & Body", "5.0"},
+	{"🧚🏻\u200d♂️", "man fairy: light skin tone", "People & Body", "5.0"},
+	{"🧚🏼\u200d♂️", "man fairy: medium-light skin tone", "People & Body", "5.0"},
+	{"🧚🏽\u200d♂️", "man fairy: medium skin tone", "People & Body", "5.0"},
+	{"🧚🏾\u200d♂️", "man fairy: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧚🏿\u200d♂️", "man fairy: dark skin tone", "People & Body", "5.0"},
+	{"🧚\u200d♀️", "woman fairy", "People & Body", "5.0"},
+	{"🧚🏻\u200d♀️", "woman fairy: light skin tone", "People & Body", "5.0"},
+	{"🧚🏼\u200d♀️", "woman fairy: medium-light skin tone", "People & Body", "5.0"},
+	{"🧚🏽\u200d♀️", "woman fairy: medium skin tone", "People & Body", "5.0"},
+	{"🧚🏾\u200d♀️", "woman fairy: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧚🏿\u200d♀️", "woman fairy: dark skin tone", "People & Body", "5.0"},
+	{"🧛", "vampire", "People & Body", "5.0"},
+	{"🧛🏻", "vampire: light skin tone", "People & Body", "5.0"},
+	{"🧛🏼", "vampire: medium-light skin tone", "People & Body", "5.0"},
+	{"🧛🏽", "vampire: medium skin tone", "People & Body", "5.0"},
+	{"🧛🏾", "vampire: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧛🏿", "vampire: dark skin tone", "People & Body", "5.0"},
+	{"🧛\u200d♂️", "man vampire", "People & Body", "5.0"},
+	{"🧛🏻\u200d♂️", "man vampire: light skin tone", "People & Body", "5.0"},
+	{"🧛🏼\u200d♂️", "man vampire: medium-light skin tone", "People & Body", "5.0"},
+	{"🧛🏽\u200d♂️", "man vampire: medium skin tone", "People & Body", "5.0"},
+	{"🧛🏾\u200d♂️", "man vampire: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧛🏿\u200d♂️", "man vampire: dark skin tone", "People & Body", "5.0"},
+	{"🧛\u200d♀️", "woman vampire", "People & Body", "5.0"},
+	{"🧛🏻\u200d♀️", "woman vampire: light skin tone", "People & Body", "5.0"},
+	{"🧛🏼\u200d♀️", "woman vampire: medium-light skin tone", "People & Body", "5.0"},
+	{"🧛🏽\u200d♀️", "woman vampire: medium skin tone", "People & Body", "5.0"},
+	{"🧛🏾\u200d♀️", "woman vampire: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧛🏿\u200d♀️", "woman vampire: dark skin tone", "People & Body", "5.0"},
+	{"🧜", "merperson", "People & Body", "5.0"},
+	{"🧜🏻", "merperson: light skin tone", "People & Body", "5.0"},
+	{"🧜🏼", "merperson: medium-light skin tone", "People & Body", "5.0"},
+	{"🧜🏽", "merperson: medium skin tone", "People & Body", "5.0"},
+	{"🧜🏾", "merperson: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧜🏿", "merperson: dark skin tone", "People & Body", "5.0"},
+	{"🧜\u200d♂️", "merman", "People & Body", "5.0"},
+	{"🧜🏻\u200d♂️", "merman: light skin tone", "People & Body", "5.0"},
+	{"🧜🏼\u200d♂️", "merman: medium-light skin tone", "People & Body", "5.0"},
+	{"🧜🏽\u200d♂️", "merman: medium skin tone", "People & Body", "5.0"},
+	{"🧜🏾\u200d♂️", "merman: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧜🏿\u200d♂️", "merman: dark skin tone", "People & Body", "5.0"},
+	{"🧜\u200d♀️", "mermaid", "People & Body", "5.0"},
+	{"🧜🏻\u200d♀️", "mermaid: light skin tone", "People & Body", "5.0"},
+	{"🧜🏼\u200d♀️", "mermaid: medium-light skin tone", "People & Body", "5.0"},
+	{"🧜🏽\u200d♀️", "mermaid: medium skin tone", "People & Body", "5.0"},
+	{"🧜🏾\u200d♀️", "mermaid: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧜🏿\u200d♀️", "mermaid: dark skin tone", "People & Body", "5.0"},
+	{"🧝", "elf", "People & Body", "5.0"},
+	{"🧝🏻", "elf: light skin tone", "People & Body", "5.0"},
+	{"🧝🏼", "elf: medium-light skin tone", "People & Body", "5.0"},
+	{"🧝🏽", "elf: medium skin tone", "People & Body", "5.0"},
+	{"🧝🏾", "elf: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧝🏿", "elf: dark skin tone", "People & Body", "5.0"},
+	{"🧝\u200d♂️", "man elf", "People & Body", "5.0"},
+	{"🧝🏻\u200d♂️", "man elf: light skin tone", "People & Body", "5.0"},
+	{"🧝🏼\u200d♂️", "man elf: medium-light skin tone", "People & Body", "5.0"},
+	{"🧝🏽\u200d♂️", "man elf: medium skin tone", "People & Body", "5.0"},
+	{"🧝🏾\u200d♂️", "man elf: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧝🏿\u200d♂️", "man elf: dark skin tone", "People & Body", "5.0"},
+	{"🧝\u200d♀️", "woman elf", "People & Body", "5.0"},
+	{"🧝🏻\u200d♀️", "woman elf: light skin tone", "People & Body", "5.0"},
+	{"🧝🏼\u200d♀️", "woman elf: medium-light skin tone", "People & Body", "5.0"},
+	{"🧝🏽\u200d♀️", "woman elf: medium skin tone", "People & Body", "5.0"},
+	{"🧝🏾\u200d♀️", "woman elf: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧝🏿\u200d♀️", "woman elf: dark skin tone", "People & Body", "5.0"},
+	{"🧞", "genie", "People & Body", "5.0"},
+	{"🧞\u200d♂️", "man genie", "People & Body", "5.0"},
+	{"🧞\u200d♀️", "woman genie", "People & Body", "5.0"},
+	{"🧟", "zombie", "People & Body", "5.0"},
+	{"🧟\u200d♂️", "man zombie", "People & Body", "5.0"},
+	{"🧟\u200d♀️", "woman zombie", "People & Body", "5.0"},
+	{"🧌", "troll", "People & Body", "14.0"},
+	{"💆", "person getting massage", "People & Body", "0.6"},
+	{"💆🏻", "person getting massage: light skin tone", "People & Body", "1.0"},
+	{"💆🏼", "person getting massage: medium-light skin tone", "People & Body", "1.0"},
+	{"💆🏽", "person getting massage: medium skin tone", "People & Body", "1.0"},
+	{"💆🏾", "person getting massage: medium-dark skin tone", "People & Body", "1.0"},
+	{"💆🏿", "person getting massage: dark skin tone", "People & Body", "1.0"},
+	{"💆\u200d♂️", "man getting massage", "People & Body", "4.0"},
+	{"💆🏻\u200d♂️", "man getting massage: light skin tone", "People & Body", "4.0"},
+	{"💆🏼\u200d♂️", "man getting massage: medium-light skin tone", "People & Body", "4.0"},
+	{"💆🏽\u200d♂️", "man getting massage: medium skin tone", "People & Body", "4.0"},
+	{"💆🏾\u200d♂️", "man getting massage: medium-dark skin tone", "People & Body", "4.0"},
+	{"💆🏿\u200d♂️", "man getting massage: dark skin tone", "People & Body", "4.0"},
+	{"💆\u200d♀️", "woman getting massage", "People & Body", "4.0"},
+	{"💆🏻\u200d♀️", "woman getting massage: light skin tone", "People & Body", "4.0"},
+	{"💆🏼\u200d♀️", "woman getting massage: medium-light skin tone", "People & Body", "4.0"},
+	{"💆🏽\u200d♀️", "woman getting massage: medium skin tone", "People & Body", "4.0"},
+	{"💆🏾\u200d♀️", "woman getting massage: medium-dark skin tone", "People & Body", "4.0"},
+	{"💆🏿\u200d♀️", "woman getting massage: dark skin tone", "People & Body", "4.0"},
+	{"💇", "person getting haircut", "People & Body", "0.6"},
+	{"💇🏻", "person getting haircut: light skin tone", "People & Body", "1.0"},
+	{"💇🏼", "person getting haircut: medium-light skin tone", "People & Body", "1.0"},
+	{"💇🏽", "person getting haircut: medium skin tone", "People & Body", "1.0"},
+	{"💇🏾", "person getting haircut: medium-dark skin tone", "People & Body", "1.0"},
+	{"💇🏿", "person getting haircut: dark skin tone", "People & Body", "1.0"},
+	{"💇\u200d♂️", "man getting haircut", "People & Body", "4.0"},
+	{"💇🏻\u200d♂️", "man getting haircut: light skin tone", "People & Body", "4.0"},
+	{"💇🏼\u200d♂️", "man getting haircut: medium-light skin tone", "People & Body", "4.0"},
+	{"💇🏽\u200d♂️", "man getting haircut: medium skin tone", "People & Body", "4.0"},
+	{"💇🏾\u200d♂️", "man getting haircut: medium-dark skin tone", "People & Body", "4.0"},
+	{"💇🏿\u200d♂️", "man getting haircut: dark skin tone", "People & Body", "4.0"},
+	{"💇\u200d♀️", "woman getting haircut", "People & Body", "4.0"},
+	{"💇🏻\u200d♀️", "woman getting haircut: light skin tone", "People & Body", "4.0"},
+	{"💇🏼\u200d♀️", "woman getting haircut: medium-light skin tone", "People & Body", "4.0"},
+	{"💇🏽\u200d♀️", "woman getting haircut: medium skin tone", "People & Body", "4.0"},
+	{"💇🏾\u200d♀️", "woman getting haircut: medium-dark skin tone", "People & Body", "4.0"},
+	{"💇🏿\u200d♀️", "woman getting haircut: dark skin tone", "People & Body", "4.0"},
+	{"🚶", "person walking", "People & Body", "0.6"},
+	{"🚶🏻", "person walking: light skin tone", "People & Body", "1.0"},
+	{"🚶🏼", "person walking: medium-light skin tone", "People & Body", "1.0"},
+	{"🚶🏽", "person walking: medium skin tone", "People & Body", "1.0"},
+	{"🚶🏾", "person walking: medium-dark skin tone", "People & Body", "1.0"},
+	{"🚶🏿", "person walking: dark skin tone", "People & Body", "1.0"},
+	{"🚶\u200d♂️", "man walking", "People & Body", "4.0"},
+	{"🚶🏻\u200d♂️", "man walking: light skin tone", "People & Body", "4.0"},
+	{"🚶🏼\u200d♂️", "man walking: medium-light skin tone", "People & Body", "4.0"},
+	{"🚶🏽\u200d♂️", "man walking: medium skin tone", "People & Body", "4.0"},
+	{"🚶🏾\u200d♂️", "man walking: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚶🏿\u200d♂️", "man walking: dark skin tone", "People & Body", "4.0"},
+	{"🚶\u200d♀️", "woman walking", "People & Body", "4.0"},
+	{"🚶🏻\u200d♀️", "woman walking: light skin tone", "People & Body", "4.0"},
+	{"🚶🏼\u200d♀️", "woman walking: medium-light skin tone", "People & Body", "4.0"},
+	{"🚶🏽\u200d♀️", "woman walking: medium skin tone", "People & Body", "4.0"},
+	{"🚶🏾\u200d♀️", "woman walking: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚶🏿\u200d♀️", "woman walking: dark skin tone", "People & Body", "4.0"},
+	{"🚶\u200d➡️", "person walking facing right", "People & Body", "15.1"},
+	{"🚶🏻\u200d➡️", "person walking facing right: light skin tone", "People & Body", "15.1"},
+	{"🚶🏼\u200d➡️", "person walking facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🚶🏽\u200d➡️", "person walking facing right: medium skin tone", "People & Body", "15.1"},
+	{"🚶🏾\u200d➡️", "person walking facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🚶🏿\u200d➡️", "person walking facing right: dark skin tone", "People & Body", "15.1"},
+	{"🚶\u200d♀️\u200d➡️", "woman walking facing right", "People & Body", "15.1"},
+	{"🚶🏻\u200d♀️\u200d➡️", "woman walking facing right: light skin tone", "People & Body", "15.1"},
+	{"🚶🏼\u200d♀️\u200d➡️", "woman walking facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🚶🏽\u200d♀️\u200d➡️", "woman walking facing right: medium skin tone", "People & Body", "15.1"},
+	{"🚶🏾\u200d♀️\u200d➡️", "woman walking facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🚶🏿\u200d♀️\u200d➡️", "woman walking facing right: dark skin tone", "People & Body", "15.1"},
+	{"🚶\u200d♂️\u200d➡️", "man walking facing right", "People & Body", "15.1"},
+	{"🚶🏻\u200d♂️\u200d➡️", "man walking facing right: light skin tone", "People & Body", "15.1"},
+	{"🚶🏼\u200d♂️\u200d➡️", "man walking facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🚶🏽\u200d♂️\u200d➡️", "man walking facing right: medium skin tone", "People & Body", "15.1"},
+	{"🚶🏾\u200d♂️\u200d➡️", "man walking facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🚶🏿\u200d♂️\u200d➡️", "man walking facing right: dark skin tone", "People & Body", "15.1"},
+	{"🧍", "person standing", "People & Body", "12.0"},
+	{"🧍🏻", "person standing: light skin tone", "People & Body", "12.0"},
+	{"🧍🏼", "person standing: medium-light skin tone", "People & Body", "12.0"},
+	{"🧍🏽", "person standing: medium skin tone", "People & Body", "12.0"},
+	{"🧍🏾", "person standing: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧍🏿", "person standing: dark skin tone", "People & Body", "12.0"},
+	{"🧍\u200d♂️", "man standing", "People & Body", "12.0"},
+	{"🧍🏻\u200d♂️", "man standing: light skin tone", "People & Body", "12.0"},
+	{"🧍🏼\u200d♂️", "man standing: medium-light skin tone", "People & Body", "12.0"},
+	{"🧍🏽\u200d♂️", "man standing: medium skin tone", "People & Body", "12.0"},
+	{"🧍🏾\u200d♂️", "man standing: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧍🏿\u200d♂️", "man standing: dark skin tone", "People & Body", "12.0"},
+	{"🧍\u200d♀️", "woman standing", "People & Body", "12.0"},
+	{"🧍🏻\u200d♀️", "woman standing: light skin tone", "People & Body", "12.0"},
+	{"🧍🏼\u200d♀️", "woman standing: medium-light skin tone", "People & Body", "12.0"},
+	{"🧍🏽\u200d♀️", "woman standing: medium skin tone", "People & Body", "12.0"},
+	{"🧍🏾\u200d♀️", "woman standing: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧍🏿\u200d♀️", "woman standing: dark skin tone", "People & Body", "12.0"},
+	{"🧎", "person kneeling", "People & Body", "12.0"},
+	{"🧎🏻", "person kneeling: light skin tone", "People & Body", "12.0"},
+	{"🧎🏼", "person kneeling: medium-light skin tone", "People & Body", "12.0"},
+	{"🧎🏽", "person kneeling: medium skin tone", "People & Body", "12.0"},
+	{"🧎🏾", "person kneeling: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧎🏿", "person kneeling: dark skin tone", "People & Body", "12.0"},
+	{"🧎\u200d♂️", "man kneeling", "People & Body", "12.0"},
+	{"🧎🏻\u200d♂️", "man kneeling: light skin tone", "People & Body", "12.0"},
+	{"🧎🏼\u200d♂️", "man kneeling: medium-light skin tone", "People & Body", "12.0"},
+	{"🧎🏽\u200d♂️", "man kneeling: medium skin tone", "People & Body", "12.0"},
+	{"🧎🏾\u200d♂️", "man kneeling: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧎🏿\u200d♂️", "man kneeling: dark skin tone", "People & Body", "12.0"},
+	{"🧎\u200d♀️", "woman kneeling", "People & Body", "12.0"},
+	{"🧎🏻\u200d♀️", "woman kneeling: light skin tone", "People & Body", "12.0"},
+	{"🧎🏼\u200d♀️", "woman kneeling: medium-light skin tone", "People & Body", "12.0"},
+	{"🧎🏽\u200d♀️", "woman kneeling: medium skin tone", "People & Body", "12.0"},
+	{"🧎🏾\u200d♀️", "woman kneeling: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧎🏿\u200d♀️", "woman kneeling: dark skin tone", "People & Body", "12.0"},
+	{"🧎\u200d➡️", "person kneeling facing right", "People & Body", "15.1"},
+	{"🧎🏻\u200d➡️", "person kneeling facing right: light skin tone", "People & Body", "15.1"},
+	{"🧎🏼\u200d➡️", "person kneeling facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🧎🏽\u200d➡️", "person kneeling facing right: medium skin tone", "People & Body", "15.1"},
+	{"🧎🏾\u200d➡️", "person kneeling facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🧎🏿\u200d➡️", "person kneeling facing right: dark skin tone", "People & Body", "15.1"},
+	{"🧎\u200d♀️\u200d➡️", "woman kneeling facing right", "People & Body", "15.1"},
+	{"🧎🏻\u200d♀️\u200d➡️", "woman kneeling facing right: light skin tone", "People & Body", "15.1"},
+	{"🧎🏼\u200d♀️\u200d➡️", "woman kneeling facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🧎🏽\u200d♀️\u200d➡️", "woman kneeling facing right: medium skin tone", "People & Body", "15.1"},
+	{"🧎🏾\u200d♀️\u200d➡️", "woman kneeling facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🧎🏿\u200d♀️\u200d➡️", "woman kneeling facing right: dark skin tone", "People & Body", "15.1"},
+	{"🧎\u200d♂️\u200d➡️", "man kneeling facing right", "People & Body", "15.1"},
+	{"🧎🏻\u200d♂️\u200d➡️", "man kneeling facing right: light skin tone", "People & Body", "15.1"},
+	{"🧎🏼\u200d♂️\u200d➡️", "man kneeling facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🧎🏽\u200d♂️\u200d➡️", "man kneeling facing right: medium skin tone", "People & Body", "15.1"},
+	{"🧎🏾\u200d♂️\u200d➡️", "man kneeling facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🧎🏿\u200d♂️\u200d➡️", "man kneeling facing right: dark skin tone", "People & Body", "15.1"},
+	{"🧑\u200d🦯", "person with white cane", "People & Body", "12.1"},
+	{"🧑🏻\u200d🦯", "person with white cane: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🦯", "person with white cane: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🦯", "person with white cane: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🦯", "person with white cane: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🦯", "person with white cane: dark skin tone", "People & Body", "12.1"},
+	{"🧑\u200d🦯\u200d➡️", "person with white cane facing right", "People & Body", "15.1"},
+	{"🧑🏻\u200d🦯\u200d➡️", "person with white cane facing right: light skin tone", "People & Body", "15.1"},
+	{"🧑🏼\u200d🦯\u200d➡️", "person with white cane facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🧑🏽\u200d🦯\u200d➡️", "person with white cane facing right: medium skin tone", "People & Body", "15.1"},
+	{"🧑🏾\u200d🦯\u200d➡️", "person with white cane facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🧑🏿\u200d🦯\u200d➡️", "person with white cane facing right: dark skin tone", "People & Body", "15.1"},
+	{"👨\u200d🦯", "man with white cane", "People & Body", "12.0"},
+	{"👨🏻\u200d🦯", "man with white cane: light skin tone", "People & Body", "12.0"},
+	{"👨🏼\u200d🦯", "man with white cane: medium-light skin tone", "People & Body", "12.0"},
+	{"👨🏽\u200d🦯", "man with white cane: medium skin tone", "People & Body", "12.0"},
+	{"👨🏾\u200d🦯", "man with white cane: medium-dark skin tone", "People & Body", "12.0"},
+	{"👨🏿\u200d🦯", "man with white cane: dark skin tone", "People & Body", "12.0"},
+	{"👨\u200d🦯\u200d➡️", "man with white cane facing right", "People & Body", "15.1"},
+	{"👨🏻\u200d🦯\u200d➡️", "man with white cane facing right: light skin tone", "People & Body", "15.1"},
+	{"👨🏼\u200d🦯\u200d➡️", "man with white cane facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"👨🏽\u200d🦯\u200d➡️", "man with white cane facing right: medium skin tone", "People & Body", "15.1"},
+	{"👨🏾\u200d🦯\u200d➡️", "man with white cane facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"👨🏿\u200d🦯\u200d➡️", "man with white cane facing right: dark skin tone", "People & Body", "15.1"},
+	{"👩\u200d🦯", "woman with white cane", "People & Body", "12.0"},
+	{"👩🏻\u200d🦯", "woman with white cane: light skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🦯", "woman with white cane: medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🦯", "woman with white cane: medium skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🦯", "woman with white cane: medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🦯", "woman with white cane: dark skin tone", "People & Body", "12.0"},
+	{"👩\u200d🦯\u200d➡️", "woman with white cane facing right", "People & Body", "15.1"},
+	{"👩🏻\u200d🦯\u200d➡️", "woman with white cane facing right: light skin tone", "People & Body", "15.1"},
+	{"👩🏼\u200d🦯\u200d➡️", "woman with white cane facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"👩🏽\u200d🦯\u200d➡️", "woman with white cane facing right: medium skin tone", "People & Body", "15.1"},
+	{"👩🏾\u200d🦯\u200d➡️", "woman with white cane facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"👩🏿\u200d🦯\u200d➡️", "woman with white cane facing right: dark skin tone", "People & Body", "15.1"},
+	{"🧑\u200d🦼", "person in motorized wheelchair", "People & Body", "12.1"},
+	{"🧑🏻\u200d🦼", "person in motorized wheelchair: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🦼", "person in motorized wheelchair: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🦼", "person in motorized wheelchair: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🦼", "person in motorized wheelchair: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🦼", "person in motorized wheelchair: dark skin tone", "People & Body", "12.1"},
+	{"🧑\u200d🦼\u200d➡️", "person in motorized wheelchair facing right", "People & Body", "15.1"},
+	{"🧑🏻\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: light skin tone", "People & Body", "15.1"},
+	{"🧑🏼\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🧑🏽\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: medium skin tone", "People & Body", "15.1"},
+	{"🧑🏾\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🧑🏿\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: dark skin tone", "People & Body", "15.1"},
+	{"👨\u200d🦼", "man in motorized wheelchair", "People & Body", "12.0"},
+	{"👨🏻\u200d🦼", "man in motorized wheelchair: light skin tone", "People & Body", "12.0"},
+	{"👨🏼\u200d🦼", "man in motorized wheelchair: medium-light skin tone", "People & Body", "12.0"},
+	{"👨🏽\u200d🦼", "man in motorized wheelchair: medium skin tone", "People & Body", "12.0"},
+	{"👨🏾\u200d🦼", "man in motorized wheelchair: medium-dark skin tone", "People & Body", "12.0"},
+	{"👨🏿\u200d🦼", "man in motorized wheelchair: dark skin tone", "People & Body", "12.0"},
+	{"👨\u200d🦼\u200d➡️", "man in motorized wheelchair facing right", "People & Body", "15.1"},
+	{"👨🏻\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: light skin tone", "People & Body", "15.1"},
+	{"👨🏼\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"👨🏽\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: medium skin tone", "People & Body", "15.1"},
+	{"👨🏾\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"👨🏿\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: dark skin tone", "People & Body", "15.1"},
+	{"👩\u200d🦼", "woman in motorized wheelchair", "People & Body", "12.0"},
+	{"👩🏻\u200d🦼", "woman in motorized wheelchair: light skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🦼", "woman in motorized wheelchair: medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🦼", "woman in motorized wheelchair: medium skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🦼", "woman in motorized wheelchair: medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🦼", "woman in motorized wheelchair: dark skin tone", "People & Body", "12.0"},
+	{"👩\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right", "People & Body", "15.1"},
+	{"👩🏻\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: light skin tone", "People & Body", "15.1"},
+	{"👩🏼\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"👩🏽\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: medium skin tone", "People & Body", "15.1"},
+	{"👩🏾\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"👩🏿\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: dark skin tone", "People & Body", "15.1"},
+	{"🧑\u200d🦽", "person in manual wheelchair", "People & Body", "12.1"},
+	{"🧑🏻\u200d🦽", "person in manual wheelchair: light skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🦽", "person in manual wheelchair: medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🦽", "person in manual wheelchair: medium skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🦽", "person in manual wheelchair: medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🦽", "person in manual wheelchair: dark skin tone", "People & Body", "12.1"},
+	{"🧑\u200d🦽\u200d➡️", "person in manual wheelchair facing right", "People & Body", "15.1"},
+	{"🧑🏻\u200d🦽\u200d➡️", "person in manual wheelchair facing right: light skin tone", "People & Body", "15.1"},
+	{"🧑🏼\u200d🦽\u200d➡️", "person in manual wheelchair facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🧑🏽\u200d🦽\u200d➡️", "person in manual wheelchair facing right: medium skin tone", "People & Body", "15.1"},
+	{"🧑🏾\u200d🦽\u200d➡️", "person in manual wheelchair facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🧑🏿\u200d🦽\u200d➡️", "person in manual wheelchair facing right: dark skin tone", "People & Body", "15.1"},
+	{"👨\u200d🦽", "man in manual wheelchair", "People & Body", "12.0"},
+	{"👨🏻\u200d🦽", "man in manual wheelchair: light skin tone", "People & Body", "12.0"},
+	{"👨🏼\u200d🦽", "man in manual wheelchair: medium-light skin tone", "People & Body", "12.0"},
+	{"👨🏽\u200d🦽", "man in manual wheelchair: medium skin tone", "People & Body", "12.0"},
+	{"👨🏾\u200d🦽", "man in manual wheelchair: medium-dark skin tone", "People & Body", "12.0"},
+	{"👨🏿\u200d🦽", "man in manual wheelchair: dark skin tone", "People & Body", "12.0"},
+	{"👨\u200d🦽\u200d➡️", "man in manual wheelchair facing right", "People & Body", "15.1"},
+	{"👨🏻\u200d🦽\u200d➡️", "man in manual wheelchair facing right: light skin tone", "People & Body", "15.1"},
+	{"👨🏼\u200d🦽\u200d➡️", "man in manual wheelchair facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"👨🏽\u200d🦽\u200d➡️", "man in manual wheelchair facing right: medium skin tone", "People & Body", "15.1"},
+	{"👨🏾\u200d🦽\u200d➡️", "man in manual wheelchair facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"👨🏿\u200d🦽\u200d➡️", "man in manual wheelchair facing right: dark skin tone", "People & Body", "15.1"},
+	{"👩\u200d🦽", "woman in manual wheelchair", "People & Body", "12.0"},
+	{"👩🏻\u200d🦽", "woman in manual wheelchair: light skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🦽", "woman in manual wheelchair: medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🦽", "woman in manual wheelchair: medium skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🦽", "woman in manual wheelchair: medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🦽", "woman in manual wheelchair: dark skin tone", "People & Body", "12.0"},
+	{"👩\u200d🦽\u200d➡️", "woman in manual wheelchair facing right", "People & Body", "15.1"},
+	{"👩🏻\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: light skin tone", "People & Body", "15.1"},
+	{"👩🏼\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"👩🏽\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: medium skin tone", "People & Body", "15.1"},
+	{"👩🏾\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"👩🏿\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: dark skin tone", "People & Body", "15.1"},
+	{"🏃", "person running", "People & Body", "0.6"},
+	{"🏃🏻", "person running: light skin tone", "People & Body", "1.0"},
+	{"🏃🏼", "person running: medium-light skin tone", "People & Body", "1.0"},
+	{"🏃🏽", "person running: medium skin tone", "People & Body", "1.0"},
+	{"🏃🏾", "person running: medium-dark skin tone", "People & Body", "1.0"},
+	{"🏃🏿", "person running: dark skin tone", "People & Body", "1.0"},
+	{"🏃\u200d♂️", "man running", "People & Body", "4.0"},
+	{"🏃🏻\u200d♂️", "man running: light skin tone", "People & Body", "4.0"},
+	{"🏃🏼\u200d♂️", "man running: medium-light skin tone", "People & Body", "4.0"},
+	{"🏃🏽\u200d♂️", "man running: medium skin tone", "People & Body", "4.0"},
+	{"🏃🏾\u200d♂️", "man running: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏃🏿\u200d♂️", "man running: dark skin tone", "People & Body", "4.0"},
+	{"🏃\u200d♀️", "woman running", "People & Body", "4.0"},
+	{"🏃🏻\u200d♀️", "woman running: light skin tone", "People & Body", "4.0"},
+	{"🏃🏼\u200d♀️", "woman running: medium-light skin tone", "People & Body", "4.0"},
+	{"🏃🏽\u200d♀️", "woman running: medium skin tone", "People & Body", "4.0"},
+	{"🏃🏾\u200d♀️", "woman running: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏃🏿\u200d♀️", "woman running: dark skin tone", "People & Body", "4.0"},
+	{"🏃\u200d➡️", "person running facing right", "People & Body", "15.1"},
+	{"🏃🏻\u200d➡️", "person running facing right: light skin tone", "People & Body", "15.1"},
+	{"🏃🏼\u200d➡️", "person running facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🏃🏽\u200d➡️", "person running facing right: medium skin tone", "People & Body", "15.1"},
+	{"🏃🏾\u200d➡️", "person running facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🏃🏿\u200d➡️", "person running facing right: dark skin tone", "People & Body", "15.1"},
+	{"🏃\u200d♀️\u200d➡️", "woman running facing right", "People & Body", "15.1"},
+	{"🏃🏻\u200d♀️\u200d➡️", "woman running facing right: light skin tone", "People & Body", "15.1"},
+	{"🏃🏼\u200d♀️\u200d➡️", "woman running facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🏃🏽\u200d♀️\u200d➡️", "woman running facing right: medium skin tone", "People & Body", "15.1"},
+	{"🏃🏾\u200d♀️\u200d➡️", "woman running facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🏃🏿\u200d♀️\u200d➡️", "woman running facing right: dark skin tone", "People & Body", "15.1"},
+	{"🏃\u200d♂️\u200d➡️", "man running facing right", "People & Body", "15.1"},
+	{"🏃🏻\u200d♂️\u200d➡️", "man running facing right: light skin tone", "People & Body", "15.1"},
+	{"🏃🏼\u200d♂️\u200d➡️", "man running facing right: medium-light skin tone", "People & Body", "15.1"},
+	{"🏃🏽\u200d♂️\u200d➡️", "man running facing right: medium skin tone", "People & Body", "15.1"},
+	{"🏃🏾\u200d♂️\u200d➡️", "man running facing right: medium-dark skin tone", "People & Body", "15.1"},
+	{"🏃🏿\u200d♂️\u200d➡️", "man running facing right: dark skin tone", "People & Body", "15.1"},
+	{"💃", "woman dancing", "People & Body", "0.6"},
+	{"💃🏻", "woman dancing: light skin tone", "People & Body", "1.0"},
+	{"💃🏼", "woman dancing: medium-light skin tone", "People & Body", "1.0"},
+	{"💃🏽", "woman dancing: medium skin tone", "People & Body", "1.0"},
+	{"💃🏾", "woman dancing: medium-dark skin tone", "People & Body", "1.0"},
+	{"💃🏿", "woman dancing: dark skin tone", "People & Body", "1.0"},
+	{"🕺", "man dancing", "People & Body", "3.0"},
+	{"🕺🏻", "man dancing: light skin tone", "People & Body", "3.0"},
+	{"🕺🏼", "man dancing: medium-light skin tone", "People & Body", "3.0"},
+	{"🕺🏽", "man dancing: medium skin tone", "People & Body", "3.0"},
+	{"🕺🏾", "man dancing: medium-dark skin tone", "People & Body", "3.0"},
+	{"🕺🏿", "man dancing: dark skin tone", "People & Body", "3.0"},
+	{"🕴️", "person in suit levitating", "People & Body", "0.7"},
+	{"🕴🏻", "person in suit levitating: light skin tone", "People & Body", "4.0"},
+	{"🕴🏼", "person in suit levitating: medium-light skin tone", "People & Body", "4.0"},
+	{"🕴🏽", "person in suit levitating: medium skin tone", "People & Body", "4.0"},
+	{"🕴🏾", "person in suit levitating: medium-dark skin tone", "People & Body", "4.0"},
+	{"🕴🏿", "person in suit levitating: dark skin tone", "People & Body", "4.0"},
+	{"👯", "people with bunny ears", "People & Body", "0.6"},
+	{"👯\u200d♂️", "men with bunny ears", "People & Body", "4.0"},
+	{"👯\u200d♀️", "women with bunny ears", "People & Body", "4.0"},
+	{"🧖", "person in steamy room", "People & Body", "5.0"},
+	{"🧖🏻", "person in steamy room: light skin tone", "People & Body", "5.0"},
+	{"🧖🏼", "person in steamy room: medium-light skin tone", "People & Body", "5.0"},
+	{"🧖🏽", "person in steamy room: medium skin tone", "People & Body", "5.0"},
+	{"🧖🏾", "person in steamy room: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧖🏿", "person in steamy room: dark skin tone", "People & Body", "5.0"},
+	{"🧖\u200d♂️", "man in steamy room", "People & Body", "5.0"},
+	{"🧖🏻\u200d♂️", "man in steamy room: light skin tone", "People & Body", "5.0"},
+	{"🧖🏼\u200d♂️", "man in steamy room: medium-light skin tone", "People & Body", "5.0"},
+	{"🧖🏽\u200d♂️", "man in steamy room: medium skin tone", "People & Body", "5.0"},
+	{"🧖🏾\u200d♂️", "man in steamy room: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧖🏿\u200d♂️", "man in steamy room: dark skin tone", "People & Body", "5.0"},
+	{"🧖\u200d♀️", "woman in steamy room", "People & Body", "5.0"},
+	{"🧖🏻\u200d♀️", "woman in steamy room: light skin tone", "People & Body", "5.0"},
+	{"🧖🏼\u200d♀️", "woman in steamy room: medium-light skin tone", "People & Body", "5.0"},
+	{"🧖🏽\u200d♀️", "woman in steamy room: medium skin tone", "People & Body", "5.0"},
+	{"🧖🏾\u200d♀️", "woman in steamy room: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧖🏿\u200d♀️", "woman in steamy room: dark skin tone", "People & Body", "5.0"},
+	{"🧗", "person climbing", "People & Body", "5.0"},
+	{"🧗🏻", "person climbing: light skin tone", "People & Body", "5.0"},
+	{"🧗🏼", "person climbing: medium-light skin tone", "People & Body", "5.0"},
+	{"🧗🏽", "person climbing: medium skin tone", "People & Body", "5.0"},
+	{"🧗🏾", "person climbing: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧗🏿", "person climbing: dark skin tone", "People & Body", "5.0"},
+	{"🧗\u200d♂️", "man climbing", "People & Body", "5.0"},
+	{"🧗🏻\u200d♂️", "man climbing: light skin tone", "People & Body", "5.0"},
+	{"🧗🏼\u200d♂️", "man climbing: medium-light skin tone", "People & Body", "5.0"},
+	{"🧗🏽\u200d♂️", "man climbing: medium skin tone", "People & Body", "5.0"},
+	{"🧗🏾\u200d♂️", "man climbing: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧗🏿\u200d♂️", "man climbing: dark skin tone", "People & Body", "5.0"},
+	{"🧗\u200d♀️", "woman climbing", "People & Body", "5.0"},
+	{"🧗🏻\u200d♀️", "woman climbing: light skin tone", "People & Body", "5.0"},
+	{"🧗🏼\u200d♀️", "woman climbing: medium-light skin tone", "People & Body", "5.0"},
+	{"🧗🏽\u200d♀️", "woman climbing: medium skin tone", "People & Body", "5.0"},
+	{"🧗🏾\u200d♀️", "woman climbing: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧗🏿\u200d♀️", "woman climbing: dark skin tone", "People & Body", "5.0"},
+	{"🤺", "person fencing", "People & Body", "3.0"},
+	{"🏇", "horse racing", "People & Body", "1.0"},
+	{"🏇🏻", "horse racing: light skin tone", "People & Body", "1.0"},
+	{"🏇🏼", "horse racing: medium-light skin tone", "People & Body", "1.0"},
+	{"🏇🏽", "horse racing: medium skin tone", "People & Body", "1.0"},
+	{"🏇🏾", "horse racing: medium-dark skin tone", "People & Body", "1.0"},
+	{"🏇🏿", "horse racing: dark skin tone", "People & Body", "1.0"},
+	{"⛷️", "skier", "People & Body", "0.7"},
+	{"🏂", "snowboarder", "People & Body", "0.6"},
+	{"🏂🏻", "snowboarder: light skin tone", "People & Body", "1.0"},
+	{"🏂🏼", "snowboarder: medium-light skin tone", "People & Body", "1.0"},
+	{"🏂🏽", "snowboarder: medium skin tone", "People & Body", "1.0"},
+	{"🏂🏾", "snowboarder: medium-dark skin tone", "People & Body", "1.0"},
+	{"🏂🏿", "snowboarder: dark skin tone", "People & Body", "1.0"},
+	{"🏌️", "person golfing", "People & Body", "0.7"},
+	{"🏌🏻", "person golfing: light skin tone", "People & Body", "4.0"},
+	{"🏌🏼", "person golfing: medium-light skin tone", "People & Body", "4.0"},
+	{"🏌🏽", "person golfing: medium skin tone", "People & Body", "4.0"},
+	{"🏌🏾", "person golfing: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏌🏿", "person golfing: dark skin tone", "People & Body", "4.0"},
+	{"🏌️\u200d♂️", "man golfing", "People & Body", "4.0"},
+	{"🏌🏻\u200d♂️", "man golfing: light skin tone", "People & Body", "4.0"},
+	{"🏌🏼\u200d♂️", "man golfing: medium-light skin tone", "People & Body", "4.0"},
+	{"🏌🏽\u200d♂️", "man golfing: medium skin tone", "People & Body", "4.0"},
+	{"🏌🏾\u200d♂️", "man golfing: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏌🏿\u200d♂️", "man golfing: dark skin tone", "People & Body", "4.0"},
+	{"🏌️\u200d♀️", "woman golfing", "People & Body", "4.0"},
+	{"🏌🏻\u200d♀️", "woman golfing: light skin tone", "People & Body", "4.0"},
+	{"🏌🏼\u200d♀️", "woman golfing: medium-light skin tone", "People & Body", "4.0"},
+	{"🏌🏽\u200d♀️", "woman golfing: medium skin tone", "People & Body", "4.0"},
+	{"🏌🏾\u200d♀️", "woman golfing: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏌🏿\u200d♀️", "woman golfing: dark skin tone", "People & Body", "4.0"},
+	{"🏄", "person surfing", "People & Body", "0.6"},
+	{"🏄🏻", "person surfing: light skin tone", "People & Body", "1.0"},
+	{"🏄🏼", "person surfing: medium-light skin tone", "People & Body", "1.0"},
+	{"🏄🏽", "person surfing: medium skin tone", "People & Body", "1.0"},
+	{"🏄🏾", "person surfing: medium-dark skin tone", "People & Body", "1.0"},
+	{"🏄🏿", "person surfing: dark skin tone", "People & Body", "1.0"},
+	{"🏄\u200d♂️", "man surfing", "People & Body", "4.0"},
+	{"🏄🏻\u200d♂️", "man surfing: light skin tone", "People & Body", "4.0"},
+	{"🏄🏼\u200d♂️", "man surfing: medium-light skin tone", "People & Body", "4.0"},
+	{"🏄🏽\u200d♂️", "man surfing: medium skin tone", "People & Body", "4.0"},
+	{"🏄🏾\u200d♂️", "man surfing: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏄🏿\u200d♂️", "man surfing: dark skin tone", "People & Body", "4.0"},
+	{"🏄\u200d♀️", "woman surfing", "People & Body", "4.0"},
+	{"🏄🏻\u200d♀️", "woman surfing: light skin tone", "People & Body", "4.0"},
+	{"🏄🏼\u200d♀️", "woman surfing: medium-light skin tone", "People & Body", "4.0"},
+	{"🏄🏽\u200d♀️", "woman surfing: medium skin tone", "People & Body", "4.0"},
+	{"🏄🏾\u200d♀️", "woman surfing: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏄🏿\u200d♀️", "woman surfing: dark skin tone", "People & Body", "4.0"},
+	{"🚣", "person rowing boat", "People & Body", "1.0"},
+	{"🚣🏻", "person rowing boat: light skin tone", "People & Body", "1.0"},
+	{"🚣🏼", "person rowing boat: medium-light skin tone", "People & Body", "1.0"},
+	{"🚣🏽", "person rowing boat: medium skin tone", "People & Body", "1.0"},
+	{"🚣🏾", "person rowing boat: medium-dark skin tone", "People & Body", "1.0"},
+	{"🚣🏿", "person rowing boat: dark skin tone", "People & Body", "1.0"},
+	{"🚣\u200d♂️", "man rowing boat", "People & Body", "4.0"},
+	{"🚣🏻\u200d♂️", "man rowing boat: light skin tone", "People & Body", "4.0"},
+	{"🚣🏼\u200d♂️", "man rowing boat: medium-light skin tone", "People & Body", "4.0"},
+	{"🚣🏽\u200d♂️", "man rowing boat: medium skin tone", "People & Body", "4.0"},
+	{"🚣🏾\u200d♂️", "man rowing boat: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚣🏿\u200d♂️", "man rowing boat: dark skin tone", "People & Body", "4.0"},
+	{"🚣\u200d♀️", "woman rowing boat", "People & Body", "4.0"},
+	{"🚣🏻\u200d♀️", "woman rowing boat: light skin tone", "People & Body", "4.0"},
+	{"🚣🏼\u200d♀️", "woman rowing boat: medium-light skin tone", "People & Body", "4.0"},
+	{"🚣🏽\u200d♀️", "woman rowing boat: medium skin tone", "People & Body", "4.0"},
+	{"🚣🏾\u200d♀️", "woman rowing boat: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚣🏿\u200d♀️", "woman rowing boat: dark skin tone", "People & Body", "4.0"},
+	{"🏊", "person swimming", "People & Body", "0.6"},
+	{"🏊🏻", "person swimming: light skin tone", "People & Body", "1.0"},
+	{"🏊🏼", "person swimming: medium-light skin tone", "People & Body", "1.0"},
+	{"🏊🏽", "person swimming: medium skin tone", "People & Body", "1.0"},
+	{"🏊🏾", "person swimming: medium-dark skin tone", "People & Body", "1.0"},
+	{"🏊🏿", "person swimming: dark skin tone", "People & Body", "1.0"},
+	{"🏊\u200d♂️", "man swimming", "People & Body", "4.0"},
+	{"🏊🏻\u200d♂️", "man swimming: light skin tone", "People & Body", "4.0"},
+	{"🏊🏼\u200d♂️", "man swimming: medium-light skin tone", "People & Body", "4.0"},
+	{"🏊🏽\u200d♂️", "man swimming: medium skin tone", "People & Body", "4.0"},
+	{"🏊🏾\u200d♂️", "man swimming: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏊🏿\u200d♂️", "man swimming: dark skin tone", "People & Body", "4.0"},
+	{"🏊\u200d♀️", "woman swimming", "People & Body", "4.0"},
+	{"🏊🏻\u200d♀️", "woman swimming: light skin tone", "People & Body", "4.0"},
+	{"🏊🏼\u200d♀️", "woman swimming: medium-light skin tone", "People & Body", "4.0"},
+	{"🏊🏽\u200d♀️", "woman swimming: medium skin tone", "People & Body", "4.0"},
+	{"🏊🏾\u200d♀️", "woman swimming: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏊🏿\u200d♀️", "woman swimming: dark skin tone", "People & Body", "4.0"},
+	{"⛹️", "person bouncing ball", "People & Body", "0.7"},
+	{"⛹🏻", "person bouncing ball: light skin tone", "People & Body", "2.0"},
+	{"⛹🏼", "person bouncing ball: medium-light skin tone", "People & Body", "2.0"},
+	{"⛹🏽", "person bouncing ball: medium skin tone", "People & Body", "2.0"},
+	{"⛹🏾", "person bouncing ball: medium-dark skin tone", "People & Body", "2.0"},
+	{"⛹🏿", "person bouncing ball: dark skin tone", "People & Body", "2.0"},
+	{"⛹️\u200d♂️", "man bouncing ball", "People & Body", "4.0"},
+	{"⛹🏻\u200d♂️", "man bouncing ball: light skin tone", "People & Body", "4.0"},
+	{"⛹🏼\u200d♂️", "man bouncing ball: medium-light skin tone", "People & Body", "4.0"},
+	{"⛹🏽\u200d♂️", "man bouncing ball: medium skin tone", "People & Body", "4.0"},
+	{"⛹🏾\u200d♂️", "man bouncing ball: medium-dark skin tone", "People & Body", "4.0"},
+	{"⛹🏿\u200d♂️", "man bouncing ball: dark skin tone", "People & Body", "4.0"},
+	{"⛹️\u200d♀️", "woman bouncing ball", "People & Body", "4.0"},
+	{"⛹🏻\u200d♀️", "woman bouncing ball: light skin tone", "People & Body", "4.0"},
+	{"⛹🏼\u200d♀️", "woman bouncing ball: medium-light skin tone", "People & Body", "4.0"},
+	{"⛹🏽\u200d♀️", "woman bouncing ball: medium skin tone", "People & Body", "4.0"},
+	{"⛹🏾\u200d♀️", "woman bouncing ball: medium-dark skin tone", "People & Body", "4.0"},
+	{"⛹🏿\u200d♀️", "woman bouncing ball: dark skin tone", "People & Body", "4.0"},
+	{"🏋️", "person lifting weights", "People & Body", "0.7"},
+	{"🏋🏻", "person lifting weights: light skin tone", "People & Body", "2.0"},
+	{"🏋🏼", "person lifting weights: medium-light skin tone", "People & Body", "2.0"},
+	{"🏋🏽", "person lifting weights: medium skin tone", "People & Body", "2.0"},
+	{"🏋🏾", "person lifting weights: medium-dark skin tone", "People & Body", "2.0"},
+	{"🏋🏿", "person lifting weights: dark skin tone", "People & Body", "2.0"},
+	{"🏋️\u200d♂️", "man lifting weights", "People & Body", "4.0"},
+	{"🏋🏻\u200d♂️", "man lifting weights: light skin tone", "People & Body", "4.0"},
+	{"🏋🏼\u200d♂️", "man lifting weights: medium-light skin tone", "People & Body", "4.0"},
+	{"🏋🏽\u200d♂️", "man lifting weights: medium skin tone", "People & Body", "4.0"},
+	{"🏋🏾\u200d♂️", "man lifting weights: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏋🏿\u200d♂️", "man lifting weights: dark skin tone", "People & Body", "4.0"},
+	{"🏋️\u200d♀️", "woman lifting weights", "People & Body", "4.0"},
+	{"🏋🏻\u200d♀️", "woman lifting weights: light skin tone", "People & Body", "4.0"},
+	{"🏋🏼\u200d♀️", "woman lifting weights: medium-light skin tone", "People & Body", "4.0"},
+	{"🏋🏽\u200d♀️", "woman lifting weights: medium skin tone", "People & Body", "4.0"},
+	{"🏋🏾\u200d♀️", "woman lifting weights: medium-dark skin tone", "People & Body", "4.0"},
+	{"🏋🏿\u200d♀️", "woman lifting weights: dark skin tone", "People & Body", "4.0"},
+	{"🚴", "person biking", "People & Body", "1.0"},
+	{"🚴🏻", "person biking: light skin tone", "People & Body", "1.0"},
+	{"🚴🏼", "person biking: medium-light skin tone", "People & Body", "1.0"},
+	{"🚴🏽", "person biking: medium skin tone", "People & Body", "1.0"},
+	{"🚴🏾", "person biking: medium-dark skin tone", "People & Body", "1.0"},
+	{"🚴🏿", "person biking: dark skin tone", "People & Body", "1.0"},
+	{"🚴\u200d♂️", "man biking", "People & Body", "4.0"},
+	{"🚴🏻\u200d♂️", "man biking: light skin tone", "People & Body", "4.0"},
+	{"🚴🏼\u200d♂️", "man biking: medium-light skin tone", "People & Body", "4.0"},
+	{"🚴🏽\u200d♂️", "man biking: medium skin tone", "People & Body", "4.0"},
+	{"🚴🏾\u200d♂️", "man biking: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚴🏿\u200d♂️", "man biking: dark skin tone", "People & Body", "4.0"},
+	{"🚴\u200d♀️", "woman biking", "People & Body", "4.0"},
+	{"🚴🏻\u200d♀️", "woman biking: light skin tone", "People & Body", "4.0"},
+	{"🚴🏼\u200d♀️", "woman biking: medium-light skin tone", "People & Body", "4.0"},
+	{"🚴🏽\u200d♀️", "woman biking: medium skin tone", "People & Body", "4.0"},
+	{"🚴🏾\u200d♀️", "woman biking: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚴🏿\u200d♀️", "woman biking: dark skin tone", "People & Body", "4.0"},
+	{"🚵", "person mountain biking", "People & Body", "1.0"},
+	{"🚵🏻", "person mountain biking: light skin tone", "People & Body", "1.0"},
+	{"🚵🏼", "person mountain biking: medium-light skin tone", "People & Body", "1.0"},
+	{"🚵🏽", "person mountain biking: medium skin tone", "People & Body", "1.0"},
+	{"🚵🏾", "person mountain biking: medium-dark skin tone", "People & Body", "1.0"},
+	{"🚵🏿", "person mountain biking: dark skin tone", "People & Body", "1.0"},
+	{"🚵\u200d♂️", "man mountain biking", "People & Body", "4.0"},
+	{"🚵🏻\u200d♂️", "man mountain biking: light skin tone", "People & Body", "4.0"},
+	{"🚵🏼\u200d♂️", "man mountain biking: medium-light skin tone", "People & Body", "4.0"},
+	{"🚵🏽\u200d♂️", "man mountain biking: medium skin tone", "People & Body", "4.0"},
+	{"🚵🏾\u200d♂️", "man mountain biking: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚵🏿\u200d♂️", "man mountain biking: dark skin tone", "People & Body", "4.0"},
+	{"🚵\u200d♀️", "woman mountain biking", "People & Body", "4.0"},
+	{"🚵🏻\u200d♀️", "woman mountain biking: light skin tone", "People & Body", "4.0"},
+	{"🚵🏼\u200d♀️", "woman mountain biking: medium-light skin tone", "People & Body", "4.0"},
+	{"🚵🏽\u200d♀️", "woman mountain biking: medium skin tone", "People & Body", "4.0"},
+	{"🚵🏾\u200d♀️", "woman mountain biking: medium-dark skin tone", "People & Body", "4.0"},
+	{"🚵🏿\u200d♀️", "woman mountain biking: dark skin tone", "People & Body", "4.0"},
+	{"🤸", "person cartwheeling", "People & Body", "3.0"},
+	{"🤸🏻", "person cartwheeling: light skin tone", "People & Body", "3.0"},
+	{"🤸🏼", "person cartwheeling: medium-light skin tone", "People & Body", "3.0"},
+	{"🤸🏽", "person cartwheeling: medium skin tone", "People & Body", "3.0"},
+	{"🤸🏾", "person cartwheeling: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤸🏿", "person cartwheeling: dark skin tone", "People & Body", "3.0"},
+	{"🤸\u200d♂️", "man cartwheeling", "People & Body", "4.0"},
+	{"🤸🏻\u200d♂️", "man cartwheeling: light skin tone", "People & Body", "4.0"},
+	{"🤸🏼\u200d♂️", "man cartwheeling: medium-light skin tone", "People & Body", "4.0"},
+	{"🤸🏽\u200d♂️", "man cartwheeling: medium skin tone", "People & Body", "4.0"},
+	{"🤸🏾\u200d♂️", "man cartwheeling: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤸🏿\u200d♂️", "man cartwheeling: dark skin tone", "People & Body", "4.0"},
+	{"🤸\u200d♀️", "woman cartwheeling", "People & Body", "4.0"},
+	{"🤸🏻\u200d♀️", "woman cartwheeling: light skin tone", "People & Body", "4.0"},
+	{"🤸🏼\u200d♀️", "woman cartwheeling: medium-light skin tone", "People & Body", "4.0"},
+	{"🤸🏽\u200d♀️", "woman cartwheeling: medium skin tone", "People & Body", "4.0"},
+	{"🤸🏾\u200d♀️", "woman cartwheeling: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤸🏿\u200d♀️", "woman cartwheeling: dark skin tone", "People & Body", "4.0"},
+	{"🤼", "people wrestling", "People & Body", "3.0"},
+	{"🤼\u200d♂️", "men wrestling", "People & Body", "4.0"},
+	{"🤼\u200d♀️", "women wrestling", "People & Body", "4.0"},
+	{"🤽", "person playing water polo", "People & Body", "3.0"},
+	{"🤽🏻", "person playing water polo: light skin tone", "People & Body", "3.0"},
+	{"🤽🏼", "person playing water polo: medium-light skin tone", "People & Body", "3.0"},
+	{"🤽🏽", "person playing water polo: medium skin tone", "People & Body", "3.0"},
+	{"🤽🏾", "person playing water polo: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤽🏿", "person playing water polo: dark skin tone", "People & Body", "3.0"},
+	{"🤽\u200d♂️", "man playing water polo", "People & Body", "4.0"},
+	{"🤽🏻\u200d♂️", "man playing water polo: light skin tone", "People & Body", "4.0"},
+	{"🤽🏼\u200d♂️", "man playing water polo: medium-light skin tone", "People & Body", "4.0"},
+	{"🤽🏽\u200d♂️", "man playing water polo: medium skin tone", "People & Body", "4.0"},
+	{"🤽🏾\u200d♂️", "man playing water polo: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤽🏿\u200d♂️", "man playing water polo: dark skin tone", "People & Body", "4.0"},
+	{"🤽\u200d♀️", "woman playing water polo", "People & Body", "4.0"},
+	{"🤽🏻\u200d♀️", "woman playing water polo: light skin tone", "People & Body", "4.0"},
+	{"🤽🏼\u200d♀️", "woman playing water polo: medium-light skin tone", "People & Body", "4.0"},
+	{"🤽🏽\u200d♀️", "woman playing water polo: medium skin tone", "People & Body", "4.0"},
+	{"🤽🏾\u200d♀️", "woman playing water polo: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤽🏿\u200d♀️", "woman playing water polo: dark skin tone", "People & Body", "4.0"},
+	{"🤾", "person playing handball", "People & Body", "3.0"},
+	{"🤾🏻", "person playing handball: light skin tone", "People & Body", "3.0"},
+	{"🤾🏼", "person playing handball: medium-light skin tone", "People & Body", "3.0"},
+	{"🤾🏽", "person playing handball: medium skin tone", "People & Body", "3.0"},
+	{"🤾🏾", "person playing handball: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤾🏿", "person playing handball: dark skin tone", "People & Body", "3.0"},
+	{"🤾\u200d♂️", "man playing handball", "People & Body", "4.0"},
+	{"🤾🏻\u200d♂️", "man playing handball: light skin tone", "People & Body", "4.0"},
+	{"🤾🏼\u200d♂️", "man playing handball: medium-light skin tone", "People & Body", "4.0"},
+	{"🤾🏽\u200d♂️", "man playing handball: medium skin tone", "People & Body", "4.0"},
+	{"🤾🏾\u200d♂️", "man playing handball: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤾🏿\u200d♂️", "man playing handball: dark skin tone", "People & Body", "4.0"},
+	{"🤾\u200d♀️", "woman playing handball", "People & Body", "4.0"},
+	{"🤾🏻\u200d♀️", "woman playing handball: light skin tone", "People & Body", "4.0"},
+	{"🤾🏼\u200d♀️", "woman playing handball: medium-light skin tone", "People & Body", "4.0"},
+	{"🤾🏽\u200d♀️", "woman playing handball: medium skin tone", "People & Body", "4.0"},
+	{"🤾🏾\u200d♀️", "woman playing handball: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤾🏿\u200d♀️", "woman playing handball: dark skin tone", "People & Body", "4.0"},
+	{"🤹", "person juggling", "People & Body", "3.0"},
+	{"🤹🏻", "person juggling: light skin tone", "People & Body", "3.0"},
+	{"🤹🏼", "person juggling: medium-light skin tone", "People & Body", "3.0"},
+	{"🤹🏽", "person juggling: medium skin tone", "People & Body", "3.0"},
+	{"🤹🏾", "person juggling: medium-dark skin tone", "People & Body", "3.0"},
+	{"🤹🏿", "person juggling: dark skin tone", "People & Body", "3.0"},
+	{"🤹\u200d♂️", "man juggling", "People & Body", "4.0"},
+	{"🤹🏻\u200d♂️", "man juggling: light skin tone", "People & Body", "4.0"},
+	{"🤹🏼\u200d♂️", "man juggling: medium-light skin tone", "People & Body", "4.0"},
+	{"🤹🏽\u200d♂️", "man juggling: medium skin tone", "People & Body", "4.0"},
+	{"🤹🏾\u200d♂️", "man juggling: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤹🏿\u200d♂️", "man juggling: dark skin tone", "People & Body", "4.0"},
+	{"🤹\u200d♀️", "woman juggling", "People & Body", "4.0"},
+	{"🤹🏻\u200d♀️", "woman juggling: light skin tone", "People & Body", "4.0"},
+	{"🤹🏼\u200d♀️", "woman juggling: medium-light skin tone", "People & Body", "4.0"},
+	{"🤹🏽\u200d♀️", "woman juggling: medium skin tone", "People & Body", "4.0"},
+	{"🤹🏾\u200d♀️", "woman juggling: medium-dark skin tone", "People & Body", "4.0"},
+	{"🤹🏿\u200d♀️", "woman juggling: dark skin tone", "People & Body", "4.0"},
+	{"🧘", "person in lotus position", "People & Body", "5.0"},
+	{"🧘🏻", "person in lotus position: light skin tone", "People & Body", "5.0"},
+	{"🧘🏼", "person in lotus position: medium-light skin tone", "People & Body", "5.0"},
+	{"🧘🏽", "person in lotus position: medium skin tone", "People & Body", "5.0"},
+	{"🧘🏾", "person in lotus position: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧘🏿", "person in lotus position: dark skin tone", "People & Body", "5.0"},
+	{"🧘\u200d♂️", "man in lotus position", "People & Body", "5.0"},
+	{"🧘🏻\u200d♂️", "man in lotus position: light skin tone", "People & Body", "5.0"},
+	{"🧘🏼\u200d♂️", "man in lotus position: medium-light skin tone", "People & Body", "5.0"},
+	{"🧘🏽\u200d♂️", "man in lotus position: medium skin tone", "People & Body", "5.0"},
+	{"🧘🏾\u200d♂️", "man in lotus position: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧘🏿\u200d♂️", "man in lotus position: dark skin tone", "People & Body", "5.0"},
+	{"🧘\u200d♀️", "woman in lotus position", "People & Body", "5.0"},
+	{"🧘🏻\u200d♀️", "woman in lotus position: light skin tone", "People & Body", "5.0"},
+	{"🧘🏼\u200d♀️", "woman in lotus position: medium-light skin tone", "People & Body", "5.0"},
+	{"🧘🏽\u200d♀️", "woman in lotus position: medium skin tone", "People & Body", "5.0"},
+	{"🧘🏾\u200d♀️", "woman in lotus position: medium-dark skin tone", "People & Body", "5.0"},
+	{"🧘🏿\u200d♀️", "woman in lotus position: dark skin tone", "People & Body", "5.0"},
+	{"🛀", "person taking bath", "People & Body", "0.6"},
+	{"🛀🏻", "person taking bath: light skin tone", "People & Body", "1.0"},
+	{"🛀🏼", "person taking bath: medium-light skin tone", "People & Body", "1.0"},
+	{"🛀🏽", "person taking bath: medium skin tone", "People & Body", "1.0"},
+	{"🛀🏾", "person taking bath: medium-dark skin tone", "People & Body", "1.0"},
+	{"🛀🏿", "person taking bath: dark skin tone", "People & Body", "1.0"},
+	{"🛌", "person in bed", "People & Body", "1.0"},
+	{"🛌🏻", "person in bed: light skin tone", "People & Body", "4.0"},
+	{"🛌🏼", "person in bed: medium-light skin tone", "People & Body", "4.0"},
+	{"🛌🏽", "person in bed: medium skin tone", "People & Body", "4.0"},
+	{"🛌🏾", "person in bed: medium-dark skin tone", "People & Body", "4.0"},
+	{"🛌🏿", "person in bed: dark skin tone", "People & Body", "4.0"},
+	{"🧑\u200d🤝\u200d🧑", "people holding hands", "People & Body", "12.0"},
+	{"🧑🏻\u200d🤝\u200d🧑🏻", "people holding hands: light skin tone", "People & Body", "12.0"},
+	{"🧑🏻\u200d🤝\u200d🧑🏼", "people holding hands: light skin tone, medium-light skin tone", "People & Body", "12.1"},
+	{"🧑🏻\u200d🤝\u200d🧑🏽", "people holding hands: light skin tone, medium skin tone", "People & Body", "12.1"},
+	{"🧑🏻\u200d🤝\u200d🧑🏾", "people holding hands: light skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏻\u200d🤝\u200d🧑🏿", "people holding hands: light skin tone, dark skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🤝\u200d🧑🏻", "people holding hands: medium-light skin tone, light skin tone", "People & Body", "12.0"},
+	{"🧑🏼\u200d🤝\u200d🧑🏼", "people holding hands: medium-light skin tone", "People & Body", "12.0"},
+	{"🧑🏼\u200d🤝\u200d🧑🏽", "people holding hands: medium-light skin tone, medium skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🤝\u200d🧑🏾", "people holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏼\u200d🤝\u200d🧑🏿", "people holding hands: medium-light skin tone, dark skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🤝\u200d🧑🏻", "people holding hands: medium skin tone, light skin tone", "People & Body", "12.0"},
+	{"🧑🏽\u200d🤝\u200d🧑🏼", "people holding hands: medium skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"🧑🏽\u200d🤝\u200d🧑🏽", "people holding hands: medium skin tone", "People & Body", "12.0"},
+	{"🧑🏽\u200d🤝\u200d🧑🏾", "people holding hands: medium skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"🧑🏽\u200d🤝\u200d🧑🏿", "people holding hands: medium skin tone, dark skin tone", "People & Body", "12.1"},
+	{"🧑🏾\u200d🤝\u200d🧑🏻", "people holding hands: medium-dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"🧑🏾\u200d🤝\u200d🧑🏼", "people holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"🧑🏾\u200d🤝\u200d🧑🏽", "people holding hands: medium-dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"🧑🏾\u200d🤝\u200d🧑🏾", "people holding hands: medium-dark skin tone", "People & Body", "12.0"},
+	{"🧑🏾\u200d🤝\u200d🧑🏿", "people holding hands: medium-dark skin tone, dark skin tone", "People & Body", "12.1"},
+	{"🧑🏿\u200d🤝\u200d🧑🏻", "people holding hands: dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"🧑🏿\u200d🤝\u200d🧑🏼", "people holding hands: dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"🧑🏿\u200d🤝\u200d🧑🏽", "people holding hands: dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"🧑🏿\u200d🤝\u200d🧑🏾", "people holding hands: dark skin tone, medium-dark skin tone", "People & Body", "12.0"},
+	{"🧑🏿\u200d🤝\u200d🧑🏿", "people holding hands: dark skin tone", "People & Body", "12.0"},
+	{"👭", "women holding hands", "People & Body", "1.0"},
+	{"👭🏻", "women holding hands: light skin tone", "People & Body", "12.0"},
+	{"👩🏻\u200d🤝\u200d👩🏼", "women holding hands: light skin tone, medium-light skin tone", "People & Body", "12.1"},
+	{"👩🏻\u200d🤝\u200d👩🏽", "women holding hands: light skin tone, medium skin tone", "People & Body", "12.1"},
+	{"👩🏻\u200d🤝\u200d👩🏾", "women holding hands: light skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"👩🏻\u200d🤝\u200d👩🏿", "women holding hands: light skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👩🏼\u200d🤝\u200d👩🏻", "women holding hands: medium-light skin tone, light skin tone", "People & Body", "12.0"},
+	{"👭🏼", "women holding hands: medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🤝\u200d👩🏽", "women holding hands: medium-light skin tone, medium skin tone", "People & Body", "12.1"},
+	{"👩🏼\u200d🤝\u200d👩🏾", "women holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"👩🏼\u200d🤝\u200d👩🏿", "women holding hands: medium-light skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👩🏽\u200d🤝\u200d👩🏻", "women holding hands: medium skin tone, light skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🤝\u200d👩🏼", "women holding hands: medium skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👭🏽", "women holding hands: medium skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🤝\u200d👩🏾", "women holding hands: medium skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"👩🏽\u200d🤝\u200d👩🏿", "women holding hands: medium skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👩🏾\u200d🤝\u200d👩🏻", "women holding hands: medium-dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🤝\u200d👩🏼", "women holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🤝\u200d👩🏽", "women holding hands: medium-dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👭🏾", "women holding hands: medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🤝\u200d👩🏿", "women holding hands: medium-dark skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👩🏿\u200d🤝\u200d👩🏻", "women holding hands: dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🤝\u200d👩🏼", "women holding hands: dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🤝\u200d👩🏽", "women holding hands: dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🤝\u200d👩🏾", "women holding hands: dark skin tone, medium-dark skin tone", "People & Body", "12.0"},
+	{"👭🏿", "women holding hands: dark skin tone", "People & Body", "12.0"},
+	{"👫", "woman and man holding hands", "People & Body", "0.6"},
+	{"👫🏻", "woman and man holding hands: light skin tone", "People & Body", "12.0"},
+	{"👩🏻\u200d🤝\u200d👨🏼", "woman and man holding hands: light skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏻\u200d🤝\u200d👨🏽", "woman and man holding hands: light skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👩🏻\u200d🤝\u200d👨🏾", "woman and man holding hands: light skin tone, medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏻\u200d🤝\u200d👨🏿", "woman and man holding hands: light skin tone, dark skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🤝\u200d👨🏻", "woman and man holding hands: medium-light skin tone, light skin tone", "People & Body", "12.0"},
+	{"👫🏼", "woman and man holding hands: medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🤝\u200d👨🏽", "woman and man holding hands: medium-light skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🤝\u200d👨🏾", "woman and man holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏼\u200d🤝\u200d👨🏿", "woman and man holding hands: medium-light skin tone, dark skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🤝\u200d👨🏻", "woman and man holding hands: medium skin tone, light skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🤝\u200d👨🏼", "woman and man holding hands: medium skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👫🏽", "woman and man holding hands: medium skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🤝\u200d👨🏾", "woman and man holding hands: medium skin tone, medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏽\u200d🤝\u200d👨🏿", "woman and man holding hands: medium skin tone, dark skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🤝\u200d👨🏻", "woman and man holding hands: medium-dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🤝\u200d👨🏼", "woman and man holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🤝\u200d👨🏽", "woman and man holding hands: medium-dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👫🏾", "woman and man holding hands: medium-dark skin tone", "People & Body", "12.0"},
+	{"👩🏾\u200d🤝\u200d👨🏿", "woman and man holding hands: medium-dark skin tone, dark skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🤝\u200d👨🏻", "woman and man holding hands: dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🤝\u200d👨🏼", "woman and man holding hands: dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🤝\u200d👨🏽", "woman and man holding hands: dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👩🏿\u200d🤝\u200d👨🏾", "woman and man holding hands: dark skin tone, medium-dark skin tone", "People & Body", "12.0"},
+	{"👫🏿", "woman and man holding hands: dark skin tone", "People & Body", "12.0"},
+	{"👬", "men holding hands", "People & Body", "1.0"},
+	{"👬🏻", "men holding hands: light skin tone", "People & Body", "12.0"},
+	{"👨🏻\u200d🤝\u200d👨🏼", "men holding hands: light skin tone, medium-light skin tone", "People & Body", "12.1"},
+	{"👨🏻\u200d🤝\u200d👨🏽", "men holding hands: light skin tone, medium skin tone", "People & Body", "12.1"},
+	{"👨🏻\u200d🤝\u200d👨🏾", "men holding hands: light skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"👨🏻\u200d🤝\u200d👨🏿", "men holding hands: light skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👨🏼\u200d🤝\u200d👨🏻", "men holding hands: medium-light skin tone, light skin tone", "People & Body", "12.0"},
+	{"👬🏼", "men holding hands: medium-light skin tone", "People & Body", "12.0"},
+	{"👨🏼\u200d🤝\u200d👨🏽", "men holding hands: medium-light skin tone, medium skin tone", "People & Body", "12.1"},
+	{"👨🏼\u200d🤝\u200d👨🏾", "men holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"👨🏼\u200d🤝\u200d👨🏿", "men holding hands: medium-light skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👨🏽\u200d🤝\u200d👨🏻", "men holding hands: medium skin tone, light skin tone", "People & Body", "12.0"},
+	{"👨🏽\u200d🤝\u200d👨🏼", "men holding hands: medium skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👬🏽", "men holding hands: medium skin tone", "People & Body", "12.0"},
+	{"👨🏽\u200d🤝\u200d👨🏾", "men holding hands: medium skin tone, medium-dark skin tone", "People & Body", "12.1"},
+	{"👨🏽\u200d🤝\u200d👨🏿", "men holding hands: medium skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👨🏾\u200d🤝\u200d👨🏻", "men holding hands: medium-dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"👨🏾\u200d🤝\u200d👨🏼", "men holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👨🏾\u200d🤝\u200d👨🏽", "men holding hands: medium-dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👬🏾", "men holding hands: medium-dark skin tone", "People & Body", "12.0"},
+	{"👨🏾\u200d🤝\u200d👨🏿", "men holding hands: medium-dark skin tone, dark skin tone", "People & Body", "12.1"},
+	{"👨🏿\u200d🤝\u200d👨🏻", "men holding hands: dark skin tone, light skin tone", "People & Body", "12.0"},
+	{"👨🏿\u200d🤝\u200d👨🏼", "men holding hands: dark skin tone, medium-light skin tone", "People & Body", "12.0"},
+	{"👨🏿\u200d🤝\u200d👨🏽", "men holding hands: dark skin tone, medium skin tone", "People & Body", "12.0"},
+	{"👨🏿\u200d🤝\u200d👨🏾", "men holding hands: dark skin tone, medium-dark skin tone", "People & Body", "12.0"},
+	{"👬🏿", "men holding hands: dark skin tone", "People & Body", "12.0"},
+	{"💏", "kiss", "People & Body", "0.6"},
+	{"💏🏻", "kiss: light skin tone", "People & Body", "13.1"},
+	{"💏🏼", "kiss: medium-light skin tone", "People & Body", "13.1"},
+	{"💏🏽", "kiss: medium skin tone", "People & Body", "13.1"},
+	{"💏🏾", "kiss: medium-dark skin tone", "People & Body", "13.1"},
+	{"💏🏿", "kiss: dark skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩\u200d❤️\u200d💋\u200d👨", "kiss: woman, man", "People & Body", "2.0"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, medium skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, dark skin tone", "People & Body", "13.1"},
+	{"👨\u200d❤️\u200d💋\u200d👨", "kiss: man, man", "People & Body", "2.0"},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, light skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, medium skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, dark skin tone", "People & Body", "13.1"},
+	{"👩\u200d❤️\u200d💋\u200d👩", "kiss: woman, woman", "People & Body", "2.0"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, medium skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, dark skin tone", "People & Body", "13.1"},
+	{"💑", "couple with heart", "People & Body", "0.6"},
+	{"💑🏻", "couple with heart: light skin tone", "People & Body", "13.1"},
+	{"💑🏼", "couple with heart: medium-light skin tone", "People & Body", "13.1"},
+	{"💑🏽", "couple with heart: medium skin tone", "People & Body", "13.1"},
+	{"💑🏾", "couple with heart: medium-dark skin tone", "People & Body", "13.1"},
+	{"💑🏿", "couple with heart: dark skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d🧑🏼", "couple with heart: person, person, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d🧑🏽", "couple with heart: person, person, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d🧑🏾", "couple with heart: person, person, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"🧑🏻\u200d❤️\u200d🧑🏿", "couple with heart: person, person, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d🧑🏻", "couple with heart: person, person, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d🧑🏽", "couple with heart: person, person, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d🧑🏾", "couple with heart: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"🧑🏼\u200d❤️\u200d🧑🏿", "couple with heart: person, person, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d🧑🏻", "couple with heart: person, person, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d🧑🏼", "couple with heart: person, person, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d🧑🏾", "couple with heart: person, person, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"🧑🏽\u200d❤️\u200d🧑🏿", "couple with heart: person, person, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d🧑🏻", "couple with heart: person, person, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d🧑🏼", "couple with heart: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d🧑🏽", "couple with heart: person, person, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏾\u200d❤️\u200d🧑🏿", "couple with heart: person, person, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d🧑🏻", "couple with heart: person, person, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d🧑🏼", "couple with heart: person, person, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d🧑🏽", "couple with heart: person, person, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"🧑🏿\u200d❤️\u200d🧑🏾", "couple with heart: person, person, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩\u200d❤️\u200d👨", "couple with heart: woman, man", "People & Body", "2.0"},
+	{"👩🏻\u200d❤️\u200d👨🏻", "couple with heart: woman, man, light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👨🏼", "couple with heart: woman, man, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👨🏽", "couple with heart: woman, man, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👨🏾", "couple with heart: woman, man, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👨🏿", "couple with heart: woman, man, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👨🏻", "couple with heart: woman, man, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👨🏼", "couple with heart: woman, man, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👨🏽", "couple with heart: woman, man, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👨🏾", "couple with heart: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👨🏿", "couple with heart: woman, man, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👨🏻", "couple with heart: woman, man, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👨🏼", "couple with heart: woman, man, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👨🏽", "couple with heart: woman, man, medium skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👨🏾", "couple with heart: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👨🏿", "couple with heart: woman, man, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👨🏻", "couple with heart: woman, man, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👨🏼", "couple with heart: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👨🏽", "couple with heart: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👨🏾", "couple with heart: woman, man, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👨🏿", "couple with heart: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👨🏻", "couple with heart: woman, man, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👨🏼", "couple with heart: woman, man, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👨🏽", "couple with heart: woman, man, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👨🏾", "couple with heart: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👨🏿", "couple with heart: woman, man, dark skin tone", "People & Body", "13.1"},
+	{"👨\u200d❤️\u200d👨", "couple with heart: man, man", "People & Body", "2.0"},
+	{"👨🏻\u200d❤️\u200d👨🏻", "couple with heart: man, man, light skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d👨🏼", "couple with heart: man, man, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d👨🏽", "couple with heart: man, man, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d👨🏾", "couple with heart: man, man, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏻\u200d❤️\u200d👨🏿", "couple with heart: man, man, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d👨🏻", "couple with heart: man, man, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d👨🏼", "couple with heart: man, man, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d👨🏽", "couple with heart: man, man, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d👨🏾", "couple with heart: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏼\u200d❤️\u200d👨🏿", "couple with heart: man, man, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d👨🏻", "couple with heart: man, man, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d👨🏼", "couple with heart: man, man, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d👨🏽", "couple with heart: man, man, medium skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d👨🏾", "couple with heart: man, man, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏽\u200d❤️\u200d👨🏿", "couple with heart: man, man, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d👨🏻", "couple with heart: man, man, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d👨🏼", "couple with heart: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d👨🏽", "couple with heart: man, man, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d👨🏾", "couple with heart: man, man, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏾\u200d❤️\u200d👨🏿", "couple with heart: man, man, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d👨🏻", "couple with heart: man, man, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d👨🏼", "couple with heart: man, man, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d👨🏽", "couple with heart: man, man, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d👨🏾", "couple with heart: man, man, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👨🏿\u200d❤️\u200d👨🏿", "couple with heart: man, man, dark skin tone", "People & Body", "13.1"},
+	{"👩\u200d❤️\u200d👩", "couple with heart: woman, woman", "People & Body", "2.0"},
+	{"👩🏻\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, light skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏻\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, medium-light skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏼\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, medium skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, medium skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏽\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, medium skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏾\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, dark skin tone, light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, dark skin tone, medium skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "13.1"},
+	{"👩🏿\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, dark skin tone", "People & Body", "13.1"},
+	{"👨\u200d👩\u200d👦", "family: man, woman, boy", "People & Body", "2.0"},
+	{"👨\u200d👩\u200d👧", "family: man, woman, girl", "People & Body", "2.0"},
+	{"👨\u200d👩\u200d👧\u200d👦", "family: man, woman, girl, boy", "People & Body", "2.0"},
+	{"👨\u200d👩\u200d👦\u200d👦", "family: man, woman, boy, boy", "People & Body", "2.0"},
+	{"👨\u200d👩\u200d👧\u200d👧", "family: man, woman, girl, girl", "People & Body", "2.0"},
+	{"👨\u200d👨\u200d👦", "family: man, man, boy", "People & Body", "2.0"},
+	{"👨\u200d👨\u200d👧", "family: man, man, girl", "People & Body", "2.0"},
+	{"👨\u200d👨\u200d👧\u200d👦", "family: man, man, girl, boy", "People & Body", "2.0"},
+	{"👨\u200d👨\u200d👦\u200d👦", "family: man, man, boy, boy", "People & Body", "2.0"},
+	{"👨\u200d👨\u200d👧\u200d👧", "family: man, man, girl, girl", "People & Body", "2.0"},
+	{"👩\u200d👩\u200d👦", "family: woman, woman, boy", "People & Body", "2.0"},
+	{"👩\u200d👩\u200d👧", "family: woman, woman, girl", "People & Body", "2.0"},
+	{"👩\u200d👩\u200d👧\u200d👦", "family: woman, woman, girl, boy", "People & Body", "2.0"},
+	{"👩\u200d👩\u200d👦\u200d👦", "family: woman, woman, boy, boy", "People & Body", "2.0"},
+	{"👩\u200d👩\u200d👧\u200d👧", "family: woman, woman, girl, girl", "People & Body", "2.0"},
+	{"👨\u200d👦", "family: man, boy", "People & Body", "4.0"},
+	{"👨\u200d👦\u200d👦", "family: man, boy, boy", "People & Body", "4.0"},
+	{"👨\u200d👧", "family: man, girl", "People & Body", "4.0"},
+	{"👨\u200d👧\u200d👦", "family: man, girl, boy", "People & Body", "4.0"},
+	{"👨\u200d👧\u200d👧", "family: man, girl, girl", "People & Body", "4.0"},
+	{"👩\u200d👦", "family: woman, boy", "People & Body", "4.0"},
+	{"👩\u200d👦\u200d👦", "family: woman, boy, boy", "People & Body", "4.0"},
+	{"👩\u200d👧", "family: woman, girl", "People & Body", "4.0"},
+	{"👩\u200d👧\u200d👦", "family: woman, girl, boy", "People & Body", "4.0"},
+	{"👩\u200d👧\u200d👧", "family: woman, girl, girl", "People & Body", "4.0"},
+	{"🗣️", "speaking head", "People & Body", "0.7"},
+	{"👤", "bust in silhouette", "People & Body", "0.6"},
+	{"👥", "busts in silhouette", "People & Body", "1.0"},
+	{"🫂", "people hugging", "People & Body", "13.0"},
+	{"👪", "family", "People & Body", "0.6"},
+	{"🧑\u200d🧑\u200d🧒", "family: adult, adult, child", "People & Body", "15.1"},
+	{"🧑\u200d🧑\u200d🧒\u200d🧒", "family: adult, adult, child, child", "People & Body", "15.1"},
+	{"🧑\u200d🧒", "family: adult, child", "People & Body", "15.1"},
+	{"🧑\u200d🧒\u200d🧒", "family: adult, child, child", "People & Body", "15.1"},
+	{"👣", "footprints", "People & Body", "0.6"},
+	{"🏻", "light skin tone", "Component", "1.0"},
+	{"🏼", "medium-light skin tone", "Component", "1.0"},
+	{"🏽", "medium skin tone", "Component", "1.0"},
+	{"🏾", "medium-dark skin tone", "Component", "1.0"},
+	{"🏿", "dark skin tone", "Component", "1.0"},
+	{"🦰", "red hair", "Component", "11.0"},
+	{"🦱", "curly hair", "Component", "11.0"},
+	{"🦳", "white hair", "Component", "11.0"},
+	{"🦲", "bald", "Component", "11.0"},
+	{"🐵", "monkey face", "Animals & Nature", "0.6"},
+	{"🐒", "monkey", "Animals & Nature", "0.6"},
+	{"🦍", "gorilla", "Animals & Nature", "3.0"},
+	{"🦧", "orangutan", "Animals & Nature", "12.0"},
+	{"🐶", "dog face", "Animals & Nature", "0.6"},
+	{"🐕", "dog", "Animals & Nature", "0.7"},
+	{"🦮", "guide dog", "Animals & Nature", "12.0"},
+	{"🐕\u200d🦺", "service dog", "Animals & Nature", "12.0"},
+	{"🐩", "poodle", "Animals & Nature", "0.6"},
+	{"🐺", "wolf", "Animals & Nature", "0.6"},
+	{"🦊", "fox", "Animals & Nature", "3.0"},
+	{"🦝", "raccoon", "Animals & Nature", "11.0"},
+	{"🐱", "cat face", "Animals & Nature", "0.6"},
+	{"🐈", "cat", "Animals & Nature", "0.7"},
+	{"🐈\u200d⬛", "black cat", "Animals & Nature", "13.0"},
+	{"🦁", "lion", "Animals & Nature", "1.0"},
+	{"🐯", "tiger face", "Animals & Nature", "0.6"},
+	{"🐅", "tiger", "Animals & Nature", "1.0"},
+	{"🐆", "leopard", "Animals & Nature", "1.0"},
+	{"🐴", "horse face", "Animals & Nature", "0.6"},
+	{"🫎", "moose", "Animals & Nature", "15.0"},
+	{"🫏", "donkey", "Animals & Nature", "15.0"},
+	{"🐎", "horse", "Animals & Nature", "0.6"},
+	{"🦄", "unicorn", "Animals & Nature", "1.0"},
+	{"🦓", "zebra", "Animals & Nature", "5.0"},
+	{"🦌", "deer", "Animals & Nature", "3.0"},
+	{"🦬", "bison", "Animals & Nature", "13.0"},
+	{"🐮", "cow face", "Animals & Nature", "0.6"},
+	{"🐂", "ox", "Animals & Nature", "1.0"},
+	{"🐃", "water buffalo", "Animals & Nature", "1.0"},
+	{"🐄", "cow", "Animals & Nature", "1.0"},
+	{"🐷", "pig face", "Animals & Nature", "0.6"},
+	{"🐖", "pig", "Animals & Nature", "1.0"},
+	{"🐗", "boar", "Animals & Nature", "0.6"},
+	{"🐽", "pig nose", "Animals & Nature", "0.6"},
+	{"🐏", "ram", "Animals & Nature", "1.0"},
+	{"🐑", "ewe", "Animals & Nature", "0.6"},
+	{"🐐", "goat", "Animals & Nature", "1.0"},
+	{"🐪", "camel", "Animals & Nature", "1.0"},
+	{"🐫", "two-hump camel", "Animals & Nature", "0.6"},
+	{"🦙", "llama", "Animals & Nature", "11.0"},
+	{"🦒", "giraffe", "Animals & Nature", "5.0"},
+	{"🐘", "elephant", "Animals & Nature", "0.6"},
+	{"🦣", "mammoth", "Animals & Nature", "13.0"},
+	{"🦏", "rhinoceros", "Animals & Nature", "3.0"},
+	{"🦛", "hippopotamus", "Animals & Nature", "11.0"},
+	{"🐭", "mouse face", "Animals & Nature", "0.6"},
+	{"🐁", "mouse", "Animals & Nature", "1.0"},
+	{"🐀", "rat", "Animals & Nature", "1.0"},
+	{"🐹", "hamster", "Animals & Nature", "0.6"},
+	{"🐰", "rabbit face", "Animals & Nature", "0.6"},
+	{"🐇", "rabbit", "Animals & Nature", "1.0"},
+	{"🐿️", "chipmunk", "Animals & Nature", "0.7"},
+	{"🦫", "beaver", "Animals & Nature", "13.0"},
+	{"🦔", "hedgehog", "Animals & Nature", "5.0"},
+	{"🦇", "bat", "Animals & Nature", "3.0"},
+	{"🐻", "bear", "Animals & Nature", "0.6"},
+	{"🐻\u200d❄️", "polar bear", "Animals & Nature", "13.0"},
+	{"🐨", "koala", "Animals & Nature", "0.6"},
+	{"🐼", "panda", "Animals & Nature", "0.6"},
+	{"🦥", "sloth", "Animals & Nature", "12.0"},
+	{"🦦", "otter", "Animals & Nature", "12.0"},
+	{"🦨", "skunk", "Animals & Nature", "12.0"},
+	{"🦘", "kangaroo", "Animals & Nature", "11.0"},
+	{"🦡", "badger", "Animals & Nature", "11.0"},
+	{"🐾", "paw prints", "Animals & Nature", "0.6"},
+	{"🦃", "turkey", "Animals & Nature", "1.0"},
+	{"🐔", "chicken", "Animals & Nature", "0.6"},
+	{"🐓", "rooster", "Animals & Nature", "1.0"},
+	{"🐣", "hatching chick", "Animals & Nature", "0.6"},
+	{"🐤", "baby chick", "Animals & Nature", "0.6"},
+	{"🐥", "front-facing baby chick", "Animals & Nature", "0.6"},
+	{"🐦", "bird", "Animals & Nature", "0.6"},
+	{"🐧", "penguin", "Animals & Nature", "0.6"},
+	{"🕊️", "dove", "Animals & Nature", "0.7"},
+	{"🦅", "eagle", "Animals & Nature", "3.0"},
+	{"🦆", "duck", "Animals & Nature", "3.0"},
+	{"🦢", "swan", "Animals & Nature", "11.0"},
+	{"🦉", "owl", "Animals & Nature", "3.0"},
+	{"🦤", "dodo", "Animals & Nature", "13.0"},
+	{"🪶", "feather", "Animals & Nature", "13.0"},
+	{"🦩", "flamingo", "Animals & Nature", "12.0"},
+	{"🦚", "peacock", "Animals & Nature", "11.0"},
+	{"🦜", "parrot", "Animals & Nature", "11.0"},
+	{"🪽", "wing", "Animals & Nature", "15.0"},
+	{"🐦\u200d⬛", "black bird", "Animals & Nature", "15.0"},
+	{"🪿", "goose", "Animals & Nature", "15.0"},
+	{"🐦\u200d🔥", "phoenix", "Animals & Nature", "15.1"},
+	{"🐸", "frog", "Animals & Nature", "0.6"},
+	{"🐊", "crocodile", "Animals & Nature", "1.0"},
+	{"🐢", "turtle", "Animals & Nature", "0.6"},
+	{"🦎", "lizard", "Animals & Nature", "3.0"},
+	{"🐍", "snake", "Animals & Nature", "0.6"},
+	{"🐲", "dragon face", "Animals & Nature", "0.6"},
+	{"🐉", "dragon", "Animals & Nature", "1.0"},
+	{"🦕", "sauropod", "Animals & Nature", "5.0"},
+	{"🦖", "T-Rex", "Animals & Nature", "5.0"},
+	{"🐳", "spouting whale", "Animals & Nature", "0.6"},
+	{"🐋", "whale", "Animals & Nature", "1.0"},
+	{"🐬", "dolphin", "Animals & Nature", "0.6"},
+	{"🦭", "seal", "Animals & Nature", "13.0"},
+	{"🐟", "fish", "Animals & Nature", "0.6"},
+	{"🐠", "tropical fish", "Animals & Nature", "0.6"},
+	{"🐡", "blowfish", "Animals & Nature", "0.6"},
+	{"🦈", "shark", "Animals & Nature", "3.0"},
+	{"🐙", "octopus", "Animals & Nature", "0.6"},
+	{"🐚", "spiral shell", "Animals & Nature", "0.6"},
+	{"🪸", "coral", "Animals & Nature", "14.0"},
+	{"🪼", "jellyfish", "Animals & Nature", "15.0"},
+	{"🐌", "snail", "Animals & Nature", "0.6"},
+	{"🦋", "butterfly", "Animals & Nature", "3.0"},
+	{"🐛", "bug", "Animals & Nature", "0.6"},
+	{"🐜", "ant", "Animals & Nature", "0.6"},
+	{"🐝", "honeybee", "Animals & Nature", "0.6"},
+	{"🪲", "beetle", "Animals & Nature", "13.0"},
+	{"🐞", "lady beetle", "Animals & Nature", "0.6"},
+	{"🦗", "cricket", "Animals & Nature", "5.0"},
+	{"🪳", "cockroach", "Animals & Nature", "13.0"},
+	{"🕷️", "spider", "Animals & Nature", "0.7"},
+	{"🕸️", "spider web", "Animals & Nature", "0.7"},
+	{"🦂", "scorpion", "Animals & Nature", "1.0"},
+	{"🦟", "mosquito", "Animals & Nature", "11.0"},
+	{"🪰", "fly", "Animals & Nature", "13.0"},
+	{"🪱", "worm", "Animals & Nature", "13.0"},
+	{"🦠", "microbe", "Animals & Nature", "11.0"},
+	{"💐", "bouquet", "Animals & Nature", "0.6"},
+	{"🌸", "cherry blossom", "Animals & Nature", "0.6"},
+	{"💮", "white flower", "Animals & Nature", "0.6"},
+	{"🪷", "lotus", "Animals & Nature", "14.0"},
+	{"🏵️", "rosette", "Animals & Nature", "0.7"},
+	{"🌹", "rose", "Animals & Nature", "0.6"},
+	{"🥀", "wilted flower", "Animals & Nature", "3.0"},
+	{"🌺", "hibiscus", "Animals & Nature", "0.6"},
+	{"🌻", "sunflower", "Animals & Nature", "0.6"},
+	{"🌼", "blossom", "Animals & Nature", "0.6"},
+	{"🌷", "tulip", "Animals & Nature", "0.6"},
+	{"🪻", "hyacinth", "Animals & Nature", "15.0"},
+	{"🌱", "seedling", "Animals & Nature", "0.6"},
+	{"🪴", "potted plant", "Animals & Nature", "13.0"},
+	{"🌲", "evergreen tree", "Animals & Nature", "1.0"},
+	{"🌳", "deciduous tree", "Animals & Nature", "1.0"},
+	{"🌴", "palm tree", "Animals & Nature", "0.6"},
+	{"🌵", "cactus", "Animals & Nature", "0.6"},
+	{"🌾", "sheaf of rice", "Animals & Nature", "0.6"},
+	{"🌿", "herb", "Animals & Nature", "0.6"},
+	{"☘️", "shamrock", "Animals & Nature", "1.0"},
+	{"🍀", "four leaf clover", "Animals & Nature", "0.6"},
+	{"🍁", "maple leaf", "Animals & Nature", "0.6"},
+	{"🍂", "fallen leaf", "Animals & Nature", "0.6"},
+	{"🍃", "leaf fluttering in wind", "Animals & Nature", "0.6"},
+	{"🪹", "empty nest", "Animals & Nature", "14.0"},
+	{"🪺", "nest with eggs", "Animals & Nature", "14.0"},
+	{"🍄", "mushroom", "Animals & Nature", "0.6"},
+	{"🍇", "grapes", "Food & Drink", "0.6"},
+	{"🍈", "melon", "Food & Drink", "0.6"},
+	{"🍉", "watermelon", "Food & Drink", "0.6"},
+	{"🍊", "tangerine", "Food & Drink", "0.6"},
+	{"🍋", "lemon", "Food & Drink", "1.0"},
+	{"🍋\u200d🟩", "lime", "Food & Drink", "15.1"},
+	{"🍌", "banana", "Food & Drink", "0.6"},
+	{"🍍", "pineapple", "Food & Drink", "0.6"},
+	{"🥭", "mango", "Food & Drink", "11.0"},
+	{"🍎", "red apple", "Food & Drink", "0.6"},
+	{"🍏", "green apple", "Food & Drink", "0.6"},
+	{"🍐", "pear", "Food & Drink", "1.0"},
+	{"🍑", "peach", "Food & Drink", "0.6"},
+	{"🍒", "cherries", "Food & Drink", "0.6"},
+	{"🍓", "strawberry", "Food & Drink", "0.6"},
+	{"🫐", "blueberries", "Food & Drink", "13.0"},
+	{"🥝", "kiwi fruit", "Food & Drink", "3.0"},
+	{"🍅", "tomato", "Food & Drink", "0.6"},
+	{"🫒", "olive", "Food & Drink", "13.0"},
+	{"🥥", "coconut", "Food & Drink", "5.0"},
+	{"🥑", "avocado", "Food & Drink", "3.0"},
+	{"🍆", "eggplant", "Food & Drink", "0.6"},
+	{"🥔", "potato", "Food & Drink", "3.0"},
+	{"🥕", "carrot", "Food & Drink", "3.0"},
+	{"🌽", "ear of corn", "Food & Drink", "0.6"},
+	{"🌶️", "hot pepper", "Food & Drink", "0.7"},
+	{"🫑", "bell pepper", "Food & Drink", "13.0"},
+	{"🥒", "cucumber", "Food & Drink", "3.0"},
+	{"🥬", "leafy green", "Food & Drink", "11.0"},
+	{"🥦", "broccoli", "Food & Drink", "5.0"},
+	{"🧄", "garlic", "Food & Drink", "12.0"},
+	{"🧅", "onion", "Food & Drink", "12.0"},
+	{"🥜", "peanuts", "Food & Drink", "3.0"},
+	{"🫘", "beans", "Food & Drink", "14.0"},
+	{"🌰", "chestnut", "Food & Drink", "0.6"},
+	{"🫚", "ginger root", "Food & Drink", "15.0"},
+	{"🫛", "pea pod", "Food & Drink", "15.0"},
+	{"🍄\u200d🟫", "brown mushroom", "Food & Drink", "15.1"},
+	{"🍞", "bread", "Food & Drink", "0.6"},
+	{"🥐", "croissant", "Food & Drink", "3.0"},
+	{"🥖", "baguette bread", "Food & Drink", "3.0"},
+	{"🫓", "flatbread", "Food & Drink", "13.0"},
+	{"🥨", "pretzel", "Food & Drink", "5.0"},
+	{"🥯", "bagel", "Food & Drink", "11.0"},
+	{"🥞", "pancakes", "Food & Drink", "3.0"},
+	{"🧇", "waffle", "Food & Drink", "12.0"},
+	{"🧀", "cheese wedge", "Food & Drink", "1.0"},
+	{"🍖", "meat on bone", "Food & Drink", "0.6"},
+	{"🍗", "poultry leg", "Food & Drink", "0.6"},
+	{"🥩", "cut of meat", "Food & Drink", "5.0"},
+	{"🥓", "bacon", "Food & Drink", "3.0"},
+	{"🍔", "hamburger", "Food & Drink", "0.6"},
+	{"🍟", "french fries", "Food & Drink", "0.6"},
+	{"🍕", "pizza", "Food & Drink", "0.6"},
+	{"🌭", "hot dog", "Food & Drink", "1.0"},
+	{"🥪", "sandwich", "Food & Drink", "5.0"},
+	{"🌮", "taco", "Food & Drink", "1.0"},
+	{"🌯", "burrito", "Food & Drink", "1.0"},
+	{"🫔", "tamale", "Food & Drink", "13.0"},
+	{"🥙", "stuffed flatbread", "Food & Drink", "3.0"},
+	{"🧆", "falafel", "Food & Drink", "12.0"},
+	{"🥚", "egg", "Food & Drink", "3.0"},
+	{"🍳", "cooking", "Food & Drink", "0.6"},
+	{"🥘", "shallow pan of food", "Food & Drink", "3.0"},
+	{"🍲", "pot of food", "Food & Drink", "0.6"},
+	{"🫕", "fondue", "Food & Drink", "13.0"},
+	{"🥣", "bowl with spoon", "Food & Drink", "5.0"},
+	{"🥗", "green salad", "Food & Drink", "3.0"},
+	{"🍿", "popcorn", "Food & Drink", "1.0"},
+	{"🧈", "butter", "Food & Drink", "12.0"},
+	{"🧂", "salt", "Food & Drink", "11.0"},
+	{"🥫", "canned food", "Food & Drink", "5.0"},
+	{"🍱", "bento box", "Food & Drink", "0.6"},
+	{"🍘", "rice cracker", "Food & Drink", "0.6"},
+	{"🍙", "rice ball", "Food & Drink", "0.6"},
+	{"🍚", "cooked rice", "Food & Drink", "0.6"},
+	{"🍛", "curry rice", "Food & Drink", "0.6"},
+	{"🍜", "steaming bowl", "Food & Drink", "0.6"},
+	{"🍝", "spaghetti", "Food & Drink", "0.6"},
+	{"🍠", "roasted sweet potato", "Food & Drink", "0.6"},
+	{"🍢", "oden", "Food & Drink", "0.6"},
+	{"🍣", "sushi", "Food & Drink", "0.6"},
+	{"🍤", "fried shrimp", "Food & Drink", "0.6"},
+	{"🍥", "fish cake with swirl", "Food & Drink", "0.6"},
+	{"🥮", "moon cake", "Food & Drink", "11.0"},
+	{"🍡", "dango", "Food & Drink", "0.6"},
+	{"🥟", "dumpling", "Food & Drink", "5.0"},
+	{"🥠", "fortune cookie", "Food & Drink", "5.0"},
+	{"🥡", "takeout box", "Food & Drink", "5.0"},
+	{"🦀", "crab", "Food & Drink", "1.0"},
+	{"🦞", "lobster", "Food & Drink", "11.0"},
+	{"🦐", "shrimp", "Food & Drink", "3.0"},
+	{"🦑", "squid", "Food & Drink", "3.0"},
+	{"🦪", "oyster", "Food & Drink", "12.0"},
+	{"🍦", "soft ice cream", "Food & Drink", "0.6"},
+	{"🍧", "shaved ice", "Food & Drink", "0.6"},
+	{"🍨", "ice cream", "Food & Drink", "0.6"},
+	{"🍩", "doughnut", "Food & Drink", "0.6"},
+	{"🍪", "cookie", "Food & Drink", "0.6"},
+	{"🎂", "birthday cake", "Food & Drink", "0.6"},
+	{"🍰", "shortcake", "Food & Drink", "0.6"},
+	{"🧁", "cupcake", "Food & Drink", "11.0"},
+	{"🥧", "pie", "Food & Drink", "5.0"},
+	{"🍫", "chocolate bar", "Food & Drink", "0.6"},
+	{"🍬", "candy", "Food & Drink", "0.6"},
+	{"🍭", "lollipop", "Food & Drink", "0.6"},
+	{"🍮", "custard", "Food & Drink", "0.6"},
+	{"🍯", "honey pot", "Food & Drink", "0.6"},
+	{"🍼", "baby bottle", "Food & Drink", "1.0"},
+	{"🥛", "glass of milk", "Food & Drink", "3.0"},
+	{"☕", "hot beverage", "Food & Drink", "0.6"},
+	{"🫖", "teapot", "Food & Drink", "13.0"},
+	{"🍵", "teacup without handle", "Food & Drink", "0.6"},
+	{"🍶", "sake", "Food & Drink", "0.6"},
+	{"🍾", "bottle with popping cork", "Food & Drink", "1.0"},
+	{"🍷", "wine glass", "Food & Drink", "0.6"},
+	{"🍸", "cocktail glass", "Food & Drink", "0.6"},
+	{"🍹", "tropical drink", "Food & Drink", "0.6"},
+	{"🍺", "beer mug", "Food & Drink", "0.6"},
+	{"🍻", "clinking beer mugs", "Food & Drink", "0.6"},
+	{"🥂", "clinking glasses", "Food & Drink", "3.0"},
+	{"🥃", "tumbler glass", "Food & Drink", "3.0"},
+	{"🫗", "pouring liquid", "Food & Drink", "14.0"},
+	{"🥤", "cup with straw", "Food & Drink", "5.0"},
+	{"🧋", "bubble tea", "Food & Drink", "13.0"},
+	{"🧃", "beverage box", "Food & Drink", "12.0"},
+	{"🧉", "mate", "Food & Drink", "12.0"},
+	{"🧊", "ice", "Food & Drink", "12.0"},
+	{"🥢", "chopsticks", "Food & Drink", "5.0"},
+	{"🍽️", "fork and knife with plate", "Food & Drink", "0.7"},
+	{"🍴", "fork and knife", "Food & Drink", "0.6"},
+	{"🥄", "spoon", "Food & Drink", "3.0"},
+	{"🔪", "kitchen knife", "Food & Drink", "0.6"},
+	{"🫙", "jar", "Food & Drink", "14.0"},
+	{"🏺", "amphora", "Food & Drink", "1.0"},
+	{"🌍", "globe showing Europe-Africa", "Travel & Places", "0.7"},
+	{"🌎", "globe showing Americas", "Travel & Places", "0.7"},
+	{"🌏", "globe showing Asia-Australia", "Travel & Places", "0.6"},
+	{"🌐", "globe with meridians", "Travel & Places", "1.0"},
+	{"🗺️", "world map", "Travel & Places", "0.7"},
+	{"🗾", "map of Japan", "Travel & Places", "0.6"},
+	{"🧭", "compass", "Travel & Places", "11.0"},
+	{"🏔️", "snow-capped mountain", "Travel & Places", "0.7"},
+	{"⛰️", "mountain", "Travel & Places", "0.7"},
+	{"🌋", "volcano", "Travel & Places", "0.6"},
+	{"🗻", "mount fuji", "Travel & Places", "0.6"},
+	{"🏕️", "camping", "Travel & Places", "0.7"},
+	{"🏖️", "beach with umbrella", "Travel & Places", "0.7"},
+	{"🏜️", "desert", "Travel & Places", "0.7"},
+	{"🏝️", "desert island", "Travel & Places", "0.7"},
+	{"🏞️", "national park", "Travel & Places", "0.7"},
+	{"🏟️", "stadium", "Travel & Places", "0.7"},
+	{"🏛️", "classical building", "Travel & Places", "0.7"},
+	{"🏗️", "building construction", "Travel & Places", "0.7"},
+	{"🧱", "brick", "Travel & Places", "11.0"},
+	{"🪨", "rock", "Travel & Places", "13.0"},
+	{"🪵", "wood", "Travel & Places", "13.0"},
+	{"🛖", "hut", "Travel & Places", "13.0"},
+	{"🏘️", "houses", "Travel & Places", "0.7"},
+	{"🏚️", "derelict house", "Travel & Places", "0.7"},
+	{"🏠", "house", "Travel & Places", "0.6"},
+	{"🏡", "house with garden", "Travel & Places", "0.6"},
+	{"🏢", "office building", "Travel & Places", "0.6"},
+	{"🏣", "Japanese post office", "Travel & Places", "0.6"},
+	{"🏤", "post office", "Travel & Places", "1.0"},
+	{"🏥", "hospital", "Travel & Places", "0.6"},
+	{"🏦", "bank", "Travel & Places", "0.6"},
+	{"🏨", "hotel", "Travel & Places", "0.6"},
+	{"🏩", "love hotel", "Travel & Places", "0.6"},
+	{"🏪", "convenience store", "Travel & Places", "0.6"},
+	{"🏫", "school", "Travel & Places", "0.6"},
+	{"🏬", "department store", "Travel & Places", "0.6"},
+	{"🏭", "factory", "Travel & Places", "0.6"},
+	{"🏯", "Japanese castle", "Travel & Places", "0.6"},
+	{"🏰", "castle", "Travel & Places", "0.6"},
+	{"💒", "wedding", "Travel & Places", "0.6"},
+	{"🗼", "Tokyo tower", "Travel & Places", "0.6"},
+	{"🗽", "Statue of Liberty", "Travel & Places", "0.6"},
+	{"⛪", "church", "Travel & Places", "0.6"},
+	{"🕌", "mosque", "Travel & Places", "1.0"},
+	{"🛕", "hindu temple", "Travel & Places", "12.0"},
+	{"🕍", "synagogue", "Travel & Places", "1.0"},
+	{"⛩️", "shinto shrine", "Travel & Places", "0.7"},
+	{"🕋", "kaaba", "Travel & Places", "1.0"},
+	{"⛲", "fountain", "Travel & Places", "0.6"},
+	{"⛺", "tent", "Travel & Places", "0.6"},
+	{"🌁", "foggy", "Travel & Places", "0.6"},
+	{"🌃", "night with stars", "Travel & Places", "0.6"},
+	{"🏙️", "cityscape", "Travel & Places", "0.7"},
+	{"🌄", "sunrise over mountains", "Travel & Places", "0.6"},
+	{"🌅", "sunrise", "Travel & Places", "0.6"},
+	{"🌆", "cityscape at dusk", "Travel & Places", "0.6"},
+	{"🌇", "sunset", "Travel & Places", "0.6"},
+	{"🌉", "bridge at night", "Travel & Places", "0.6"},
+	{"♨️", "hot springs", "Travel & Places", "0.6"},
+	{"🎠", "carousel horse", "Travel & Places", "0.6"},
+	{"🛝", "playground slide", "Travel & Places", "14.0"},
+	{"🎡", "ferris wheel", "Travel & Places", "0.6"},
+	{"🎢", "roller coaster", "Travel & Places", "0.6"},
+	{"💈", "barber pole", "Travel & Places", "0.6"},
+	{"🎪", "circus tent", "Travel & Places", "0.6"},
+	{"🚂", "locomotive", "Travel & Places", "1.0"},
+	{"🚃", "railway car", "Travel & Places", "0.6"},
+	{"🚄", "high-speed train", "Travel & Places", "0.6"},
+	{"🚅", "bullet train", "Travel & Places", "0.6"},
+	{"🚆", "train", "Travel & Places", "1.0"},
+	{"🚇", "metro", "Travel & Places", "0.6"},
+	{"🚈", "light rail", "Travel & Places", "1.0"},
+	{"🚉", "station", "Travel & Places", "0.6"},
+	{"🚊", "tram", "Travel & Places", "1.0"},
+	{"🚝", "monorail", "Travel & Places", "1.0"},
+	{"🚞", "mountain railway", "Travel & Places", "1.0"},
+	{"🚋", "tram car", "Travel & Places", "1.0"},
+	{"🚌", "bus", "Travel & Places", "0.6"},
+	{"🚍", "oncoming bus", "Travel & Places", "0.7"},
+	{"🚎", "trolleybus", "Travel & Places", "1.0"},
+	{"🚐", "minibus", "Travel & Places", "1.0"},
+	{"🚑", "ambulance", "Travel & Places", "0.6"},
+	{"🚒", "fire engine", "Travel & Places", "0.6"},
+	{"🚓", "police car", "Travel & Places", "0.6"},
+	{"🚔", "oncoming police car", "Travel & Places", "0.7"},
+	{"🚕", "taxi", "Travel & Places", "0.6"},
+	{"🚖", "oncoming taxi", "Travel & Places", "1.0"},
+	{"🚗", "automobile", "Travel & Places", "0.6"},
+	{"🚘", "oncoming automobile", "Travel & Places", "0.7"},
+	{"🚙", "sport utility vehicle", "Travel & Places", "0.6"},
+	{"🛻", "pickup truck", "Travel & Places", "13.0"},
+	{"🚚", "delivery truck", "Travel & Places", "0.6"},
+	{"🚛", "articulated lorry", "Travel & Places", "1.0"},
+	{"🚜", "tractor", "Travel & Places", "1.0"},
+	{"🏎️", "racing car", "Travel & Places", "0.7"},
+	{"🏍️", "motorcycle", "Travel & Places", "0.7"},
+	{"🛵", "motor scooter", "Travel & Places", "3.0"},
+	{"🦽", "manual wheelchair", "Travel & Places", "12.0"},
+	{"🦼", "motorized wheelchair", "Travel & Places", "12.0"},
+	{"🛺", "auto rickshaw", "Travel & Places", "12.0"},
+	{"🚲", "bicycle", "Travel & Places", "0.6"},
+	{"🛴", "kick scooter", "Travel & Places", "3.0"},
+	{"🛹", "skateboard", "Travel & Places", "11.0"},
+	{"🛼", "roller skate", "Travel & Places", "13.0"},
+	{"🚏", "bus stop", "Travel & Places", "0.6"},
+	{"🛣️", "motorway", "Travel & Places", "0.7"},
+	{"🛤️", "railway track", "Travel & Places", "0.7"},
+	{"🛢️", "oil drum", "Travel & Places", "0.7"},
+	{"⛽", "fuel pump", "Travel & Places", "0.6"},
+	{"🛞", "wheel", "Travel & Places", "14.0"},
+	{"🚨", "police car light", "Travel & Places", "0.6"},
+	{"🚥", "horizontal traffic light", "Travel & Places", "0.6"},
+	{"🚦", "vertical traffic light", "Travel & Places", "1.0"},
+	{"🛑", "stop sign", "Travel & Places", "3.0"},
+	{"🚧", "construction", "Travel & Places", "0.6"},
+	{"⚓", "anchor", "Travel & Places", "0.6"},
+	{"🛟", "ring buoy", "Travel & Places", "14.0"},
+	{"⛵", "sailboat", "Travel & Places", "0.6"},
+	{"🛶", "canoe", "Travel & Places", "3.0"},
+	{"🚤", "speedboat", "Travel & Places", "0.6"},
+	{"🛳️", "passenger ship", "Travel & Places", "0.7"},
+	{"⛴️", "ferry", "Travel & Places", "0.7"},
+	{"🛥️", "motor boat", "Travel & Places", "0.7"},
+	{"🚢", "ship", "Travel & Places", "0.6"},
+	{"✈️", "airplane", "Travel & Places", "0.6"},
+	{"🛩️", "small airplane", "Travel & Places", "0.7"},
+	{"🛫", "airplane departure", "Travel & Places", "1.0"},
+	{"🛬", "airplane arrival", "Travel & Places", "1.0"},
+	{"🪂", "parachute", "Travel & Places", "12.0"},
+	{"💺", "seat", "Travel & Places", "0.6"},
+	{"🚁", "helicopter", "Travel & Places", "1.0"},
+	{"🚟", "suspension railway", "Travel & Places", "1.0"},
+	{"🚠", "mountain cableway", "Travel & Places", "1.0"},
+	{"🚡", "aerial tramway", "Travel & Places", "1.0"},
+	{"🛰️", "satellite", "Travel & Places", "0.7"},
+	{"🚀", "rocket", "Travel & Places", "0.6"},
+	{"🛸", "flying saucer", "Travel & Places", "5.0"},
+	{"🛎️", "bellhop bell", "Travel & Places", "0.7"},
+	{"🧳", "luggage", "Travel & Places", "11.0"},
+	{"⌛", "hourglass done", "Travel & Places", "0.6"},
+	{"⏳", "hourglass not done", "Travel & Places", "0.6"},
+	{"⌚", "watch", "Travel & Places", "0.6"},
+	{"⏰", "alarm clock", "Travel & Places", "0.6"},
+	{"⏱️", "stopwatch", "Travel & Places", "1.0"},
+	{"⏲️", "timer clock", "Travel & Places", "1.0"},
+	{"🕰️", "mantelpiece clock", "Travel & Places", "0.7"},
+	{"🕛", "twelve o’clock", "Travel & Places", "0.6"},
+	{"🕧", "twelve-thirty", "Travel & Places", "0.7"},
+	{"🕐", "one o’clock", "Travel & Places", "0.6"},
+	{"🕜", "one-thirty", "Travel & Places", "0.7"},
+	{"🕑", "two o’clock", "Travel & Places", "0.6"},
+	{"🕝", "two-thirty", "Travel & Places", "0.7"},
+	{"🕒", "three o’clock", "Travel & Places", "0.6"},
+	{"🕞", "three-thirty", "Travel & Places", "0.7"},
+	{"🕓", "four o’clock", "Travel & Places", "0.6"},
+	{"🕟", "four-thirty", "Travel & Places", "0.7"},
+	{"🕔", "five o’clock", "Travel & Places", "0.6"},
+	{"🕠", "five-thirty", "Travel & Places", "0.7"},
+	{"🕕", "six o’clock", "Travel & Places", "0.6"},
+	{"🕡", "six-thirty", "Travel & Places", "0.7"},
+	{"🕖", "seven o’clock", "Travel & Places", "0.6"},
+	{"🕢", "seven-thirty", "Travel & Places", "0.7"},
+	{"🕗", "eight o’clock", "Travel & Places", "0.6"},
+	{"🕣", "eight-thirty", "Travel & Places", "0.7"},
+	{"🕘", "nine o’clock", "Travel & Places", "0.6"},
+	{"🕤", "nine-thirty", "Travel & Places", "0.7"},
+	{"🕙", "ten o’clock", "Travel & Places", "0.6"},
+	{"🕥", "ten-thirty", "Travel & Places", "0.7"},
+	{"🕚", "eleven o’clock", "Travel & Places", "0.6"},
+	{"🕦", "eleven-thirty", "Travel & Places", "0.7"},
+	{"🌑", "new moon", "Travel & Places", "0.6"},
+	{"🌒", "waxing crescent moon", "Travel & Places", "1.0"},
+	{"🌓", "first quarter moon", "Travel & Places", "0.6"},
+	{"🌔", "waxing gibbous moon", "Travel & Places", "0.6"},
+	{"🌕", "full moon", "Travel & Places", "0.6"},
+	{"🌖", "waning gibbous moon", "Travel & Places", "1.0"},
+	{"🌗", "last quarter moon", "Travel & Places", "1.0"},
+	{"🌘", "waning crescent moon", "Travel & Places", "1.0"},
+	{"🌙", "crescent moon", "Travel & Places", "0.6"},
+	{"🌚", "new moon face", "Travel & Places", "1.0"},
+	{"🌛", "first quarter moon face", "Travel & Places", "0.6"},
+	{"🌜", "last quarter moon face", "Travel & Places", "0.7"},
+	{"🌡️", "thermometer", "Travel & Places", "0.7"},
+	{"☀️", "sun", "Travel & Places", "0.6"},
+	{"🌝", "full moon face", "Travel & Places", "1.0"},
+	{"🌞", "sun with face", "Travel & Places", "1.0"},
+	{"🪐", "ringed planet", "Travel & Places", "12.0"},
+	{"⭐", "star", "Travel & Places", "0.6"},
+	{"🌟", "glowing star", "Travel & Places", "0.6"},
+	{"🌠", "shooting star", "Travel & Places", "0.6"},
+	{"🌌", "milky way", "Travel & Places", "0.6"},
+	{"☁️", "cloud", "Travel & Places", "0.6"},
+	{"⛅", "sun behind cloud", "Travel & Places", "0.6"},
+	{"⛈️", "cloud with lightning and rain", "Travel & Places", "0.7"},
+	{"🌤️", "sun behind small cloud", "Travel & Places", "0.7"},
+	{"🌥️", "sun behind large cloud", "Travel & Places", "0.7"},
+	{"🌦️", "sun behind rain cloud", "Travel & Places", "0.7"},
+	{"🌧️", "cloud with rain", "Travel & Places", "0.7"},
+	{"🌨️", "cloud with snow", "Travel & Places", "0.7"},
+	{"🌩️", "cloud with lightning", "Travel & Places", "0.7"},
+	{"🌪️", "tornado", "Travel & Places", "0.7"},
+	{"🌫️", "fog", "Travel & Places", "0.7"},
+	{"🌬️", "wind face", "Travel & Places", "0.7"},
+	{"🌀", "cyclone", "Travel & Places", "0.6"},
+	{"🌈", "rainbow", "Travel & Places", "0.6"},
+	{"🌂", "closed umbrella", "Travel & Places", "0.6"},
+	{"☂️", "umbrella", "Travel & Places", "0.7"},
+	{"☔", "umbrella with rain drops", "Travel & Places", "0.6"},
+	{"⛱️", "umbrella on ground", "Travel & Places", "0.7"},
+	{"⚡", "high voltage", "Travel & Places", "0.6"},
+	{"❄️", "snowflake", "Travel & Places", "0.6"},
+	{"☃️", "snowman", "Travel & Places", "0.7"},
+	{"⛄", "snowman without snow", "Travel & Places", "0.6"},
+	{"☄️", "comet", "Travel & Places", "1.0"},
+	{"🔥", "fire", "Travel & Places", "0.6"},
+	{"💧", "droplet", "Travel & Places", "0.6"},
+	{"🌊", "water wave", "Travel & Places", "0.6"},
+	{"🎃", "jack-o-lantern", "Activities", "0.6"},
+	{"🎄", "Christmas tree", "Activities", "0.6"},
+	{"🎆", "fireworks", "Activities", "0.6"},
+	{"🎇", "sparkler", "Activities", "0.6"},
+	{"🧨", "firecracker", "Activities", "11.0"},
+	{"✨", "sparkles", "Activities", "0.6"},
+	{"🎈", "balloon", "Activities", "0.6"},
+	{"🎉", "party popper", "Activities", "0.6"},
+	{"🎊", "confetti ball", "Activities", "0.6"},
+	{"🎋", "tanabata tree", "Activities", "0.6"},
+	{"🎍", "pine decoration", "Activities", "0.6"},
+	{"🎎", "Japanese dolls", "Activities", "0.6"},
+	{"🎏", "carp streamer", "Activities", "0.6"},
+	{"🎐", "wind chime", "Activities", "0.6"},
+	{"🎑", "moon viewing ceremony", "Activities", "0.6"},
+	{"🧧", "red envelope", "Activities", "11.0"},
+	{"🎀", "ribbon", "Activities", "0.6"},
+	{"🎁", "wrapped gift", "Activities", "0.6"},
+	{"🎗️", "reminder ribbon", "Activities", "0.7"},
+	{"🎟️", "admission tickets", "Activities", "0.7"},
+	{"🎫", "ticket", "Activities", "0.6"},
+	{"🎖️", "military medal", "Activities", "0.7"},
+	{"🏆", "trophy", "Activities", "0.6"},
+	{"🏅", "sports medal", "Activities", "1.0"},
+	{"🥇", "1st place medal", "Activities", "3.0"},
+	{"🥈", "2nd place medal", "Activities", "3.0"},
+	{"🥉", "3rd place medal", "Activities", "3.0"},
+	{"⚽", "soccer ball", "Activities", "0.6"},
+	{"⚾", "baseball", "Activities", "0.6"},
+	{"🥎", "softball", "Activities", "11.0"},
+	{"🏀", "basketball", "Activities", "0.6"},
+	{"🏐", "volleyball", "Activities", "1.0"},
+	{"🏈", "american football", "Activities", "0.6"},
+	{"🏉", "rugby football", "Activities", "1.0"},
+	{"🎾", "tennis", "Activities", "0.6"},
+	{"🥏", "flying disc", "Activities", "11.0"},
+	{"🎳", "bowling", "Activities", "0.6"},
+	{"🏏", "cricket game", "Activities", "1.0"},
+	{"🏑", "field hockey", "Activities", "1.0"},
+	{"🏒", "ice hockey", "Activities", "1.0"},
+	{"🥍", "lacrosse", "Activities", "11.0"},
+	{"🏓", "ping pong", "Activities", "1.0"},
+	{"🏸", "badminton", "Activities", "1.0"},
+	{"🥊", "boxing glove", "Activities", "3.0"},
+	{"🥋", "martial arts uniform", "Activities", "3.0"},
+	{"🥅", "goal net", "Activities", "3.0"},
+	{"⛳", "flag in hole", "Activities", "0.6"},
+	{"⛸️", "ice skate", "Activities", "0.7"},
+	{"🎣", "fishing pole", "Activities", "0.6"},
+	{"🤿", "diving mask", "Activities", "12.0"},
+	{"🎽", "running shirt", "Activities", "0.6"},
+	{"🎿", "skis", "Activities", "0.6"},
+	{"🛷", "sled", "Activities", "5.0"},
+	{"🥌", "curling stone", "Activities", "5.0"},
+	{"🎯", "bullseye", "Activities", "0.6"},
+	{"🪀", "yo-yo", "Activities", "12.0"},
+	{"🪁", "kite", "Activities", "12.0"},
+	{"🔫", "water pistol", "Activities", "0.6"},
+	{"🎱", "pool 8 ball", "Activities", "0.6"},
+	{"🔮", "crystal ball", "Activities", "0.6"},
+	{"🪄", "magic wand", "Activities", "13.0"},
+	{"🎮", "video game", "Activities", "0.6"},
+	{"🕹️", "joystick", "Activities", "0.7"},
+	{"🎰", "slot machine", "Activities", "0.6"},
+	{"🎲", "game die", "Activities", "0.6"},
+	{"🧩", "puzzle piece", "Activities", "11.0"},
+	{"🧸", "teddy bear", "Activities", "11.0"},
+	{"🪅", "piñata", "Activities", "13.0"},
+	{"🪩", "mirror ball", "Activities", "14.0"},
+	{"🪆", "nesting dolls", "Activities", "13.0"},
+	{"♠️", "spade suit", "Activities", "0.6"},
+	{"♥️", "heart suit", "Activities", "0.6"},
+	{"♦️", "diamond suit", "Activities", "0.6"},
+	{"♣️", "club suit", "Activities", "0.6"},
+	{"♟️", "chess pawn", "Activities", "11.0"},
+	{"🃏", "joker", "Activities", "0.6"},
+	{"🀄", "mahjong red dragon", "Activities", "0.6"},
+	{"🎴", "flower playing cards", "Activities", "0.6"},
+	{"🎭", "performing arts", "Activities", "0.6"},
+	{"🖼️", "framed picture", "Activities", "0.7"},
+	{"🎨", "artist palette", "Activities", "0.6"},
+	{"🧵", "thread", "Activities", "11.0"},
+	{"🪡", "sewing needle", "Activities", "13.0"},
+	{"🧶", "yarn", "Activities", "11.0"},
+	{"🪢", "knot", "Activities", "13.0"},
+	{"👓", "glasses", "Objects", "0.6"},
+	{"🕶️", "sunglasses", "Objects", "0.7"},
+	{"🥽", "goggles", "Objects", "11.0"},
+	{"🥼", "lab coat", "Objects", "11.0"},
+	{"🦺", "safety vest", "Objects", "12.0"},
+	{"👔", "necktie", "Objects", "0.6"},
+	{"👕", "t-shirt", "Objects", "0.6"},
+	{"👖", "jeans", "Objects", "0.6"},
+	{"🧣", "scarf", "Objects", "5.0"},
+	{"🧤", "gloves", "Objects", "5.0"},
+	{"🧥", "coat", "Objects", "5.0"},
+	{"🧦", "socks", "Objects", "5.0"},
+	{"👗", "dress", "Objects", "0.6"},
+	{"👘", "kimono", "Objects", "0.6"},
+	{"🥻", "sari", "Objects", "12.0"},
+	{"🩱", "one-piece swimsuit", "Objects", "12.0"},
+	{"🩲", "briefs", "Objects", "12.0"},
+	{"🩳", "shorts", "Objects", "12.0"},
+	{"👙", "bikini", "Objects", "0.6"},
+	{"👚", "woman’s clothes", "Objects", "0.6"},
+	{"🪭", "folding hand fan", "Objects", "15.0"},
+	{"👛", "purse", "Objects", "0.6"},
+	{"👜", "handbag", "Objects", "0.6"},
+	{"👝", "clutch bag", "Objects", "0.6"},
+	{"🛍️", "shopping bags", "Objects", "0.7"},
+	{"🎒", "backpack", "Objects", "0.6"},
+	{"🩴", "thong sandal", "Objects", "13.0"},
+	{"👞", "man’s shoe", "Objects", "0.6"},
+	{"👟", "running shoe", "Objects", "0.6"},
+	{"🥾", "hiking boot", "Objects", "11.0"},
+	{"🥿", "flat shoe", "Objects", "11.0"},
+	{"👠", "high-heeled shoe", "Objects", "0.6"},
+	{"👡", "woman’s sandal", "Objects", "0.6"},
+	{"🩰", "ballet shoes", "Objects", "12.0"},
+	{"👢", "woman’s boot", "Objects", "0.6"},
+	{"🪮", "hair pick", "Objects", "15.0"},
+	{"👑", "crown", "Objects", "0.6"},
+	{"👒", "woman’s hat", "Objects", "0.6"},
+	{"🎩", "top hat", "Objects", "0.6"},
+	{"🎓", "graduation cap", "Objects", "0.6"},
+	{"🧢", "billed cap", "Objects", "5.0"},
+	{"🪖", "military helmet", "Objects", "13.0"},
+	{"⛑️", "rescue worker’s helmet", "Objects", "0.7"},
+	{"📿", "prayer beads", "Objects", "1.0"},
+	{"💄", "lipstick", "Objects", "0.6"},
+	{"💍", "ring", "Objects", "0.6"},
+	{"💎", "gem stone", "Objects", "0.6"},
+	{"🔇", "muted speaker", "Objects", "1.0"},
+	{"🔈", "speaker low volume", "Objects", "0.7"},
+	{"🔉", "speaker medium volume", "Objects", "1.0"},
+	{"🔊", "speaker high volume", "Objects", "0.6"},
+	{"📢", "loudspeaker", "Objects", "0.6"},
+	{"📣", "megaphone", "Objects", "0.6"},
+	{"📯", "postal horn", "Objects", "1.0"},
+	{"🔔", "bell", "Objects", "0.6"},
+	{"🔕", "bell with slash", "Objects", "1.0"},
+	{"🎼", "musical score", "Objects", "0.6"},
+	{"🎵", "musical note", "Objects", "0.6"},
+	{"🎶", "musical notes", "Objects", "0.6"},
+	{"🎙️", "studio microphone", "Objects", "0.7"},
+	{"🎚️", "level slider", "Objects", "0.7"},
+	{"🎛️", "control knobs", "Objects", "0.7"},
+	{"🎤", "microphone", "Objects", "0.6"},
+	{"🎧", "headphone", "Objects", "0.6"},
+	{"📻", "radio", "Objects", "0.6"},
+	{"🎷", "saxophone", "Objects", "0.6"},
+	{"🪗", "accordion", "Objects", "13.0"},
+	{"🎸", "guitar", "Objects", "0.6"},
+	{"🎹", "musical keyboard", "Objects", "0.6"},
+	{"🎺", "trumpet", "Objects", "0.6"},
+	{"🎻", "violin", "Objects", "0.6"},
+	{"🪕", "banjo", "Objects", "12.0"},
+	{"🥁", "drum", "Objects", "3.0"},
+	{"🪘", "long drum", "Objects", "13.0"},
+	{"🪇", "maracas", "Objects", "15.0"},
+	{"🪈", "flute", "Objects", "15.0"},
+	{"📱", "mobile phone", "Objects", "0.6"},
+	{"📲", "mobile phone with arrow", "Objects", "0.6"},
+	{"☎️", "telephone", "Objects", "0.6"},
+	{"📞", "telephone receiver", "Objects", "0.6"},
+	{"📟", "pager", "Objects", "0.6"},
+	{"📠", "fax machine", "Objects", "0.6"},
+	{"🔋", "battery", "Objects", "0.6"},
+	{"🪫", "low battery", "Objects", "14.0"},
+	{"🔌", "electric plug", "Objects", "0.6"},
+	{"💻", "laptop", "Objects", "0.6"},
+	{"🖥️", "desktop computer", "Objects", "0.7"},
+	{"🖨️", "printer", "Objects", "0.7"},
+	{"⌨️", "keyboard", "Objects", "1.0"},
+	{"🖱️", "computer mouse", "Objects", "0.7"},
+	{"🖲️", "trackball", "Objects", "0.7"},
+	{"💽", "computer disk", "Objects", "0.6"},
+	{"💾", "floppy disk", "Objects", "0.6"},
+	{"💿", "optical disk", "Objects", "0.6"},
+	{"📀", "dvd", "Objects", "0.6"},
+	{"🧮", "abacus", "Objects", "11.0"},
+	{"🎥", "movie camera", "Objects", "0.6"},
+	{"🎞️", "film frames", "Objects", "0.7"},
+	{"📽️", "film projector", "Objects", "0.7"},
+	{"🎬", "clapper board", "Objects", "0.6"},
+	{"📺", "television", "Objects", "0.6"},
+	{"📷", "camera", "Objects", "0.6"},
+	{"📸", "camera with flash", "Objects", "1.0"},
+	{"📹", "video camera", "Objects", "0.6"},
+	{"📼", "videocassette", "Objects", "0.6"},
+	{"🔍", "magnifying glass tilted left", "Objects", "0.6"},
+	{"🔎", "magnifying glass tilted right", "Objects", "0.6"},
+	{"🕯️", "candle", "Objects", "0.7"},
+	{"💡", "light bulb", "Objects", "0.6"},
+	{"🔦", "flashlight", "Objects", "0.6"},
+	{"🏮", "red paper lantern", "Objects", "0.6"},
+	{"🪔", "diya lamp", "Objects", "12.0"},
+	{"📔", "notebook with decorative cover", "Objects", "0.6"},
+	{"📕", "closed book", "Objects", "0.6"},
+	{"📖", "open book", "Objects", "0.6"},
+	{"📗", "green book", "Objects", "0.6"},
+	{"📘", "blue book", "Objects", "0.6"},
+	{"📙", "orange book", "Objects", "0.6"},
+	{"📚", "books", "Objects", "0.6"},
+	{"📓", "notebook", "Objects", "0.6"},
+	{"📒", "ledger", "Objects", "0.6"},
+	{"📃", "page with curl", "Objects", "0.6"},
+	{"📜", "scroll", "Objects", "0.6"},
+	{"📄", "page facing up", "Objects", "0.6"},
+	{"📰", "newspaper", "Objects", "0.6"},
+	{"🗞️", "rolled-up newspaper", "Objects", "0.7"},
+	{"📑", "bookmark tabs", "Objects", "0.6"},
+	{"🔖", "bookmark", "Objects", "0.6"},
+	{"🏷️", "label", "Objects", "0.7"},
+	{"💰", "money bag", "Objects", "0.6"},
+	{"🪙", "coin", "Objects", "13.0"},
+	{"💴", "yen banknote", "Objects", "0.6"},
+	{"💵", "dollar banknote", "Objects", "0.6"},
+	{"💶", "euro banknote", "Objects", "1.0"},
+	{"💷", "pound banknote", "Objects", "1.0"},
+	{"💸", "money with wings", "Objects", "0.6"},
+	{"💳", "credit card", "Objects", "0.6"},
+	{"🧾", "receipt", "Objects", "11.0"},
+	{"💹", "chart increasing with yen", "Objects", "0.6"},
+	{"✉️", "envelope", "Objects", "0.6"},
+	{"📧", "e-mail", "Objects", "0.6"},
+	{"📨", "incoming envelope", "Objects", "0.6"},
+	{"📩", "envelope with arrow", "Objects", "0.6"},
+	{"📤", "outbox tray", "Objects", "0.6"},
+	{"📥", "inbox tray", "Objects", "0.6"},
+	{"📦", "package", "Objects", "0.6"},
+	{"📫", "closed mailbox with raised flag", "Objects", "0.6"},
+	{"📪", "closed mailbox with lowered flag", "Objects", "0.6"},
+	{"📬", "open mailbox with raised flag", "Objects", "0.7"},
+	{"📭", "open mailbox with lowered flag", "Objects", "0.7"},
+	{"📮", "postbox", "Objects", "0.6"},
+	{"🗳️", "ballot box with ballot", "Objects", "0.7"},
+	{"✏️", "pencil", "Objects", "0.6"},
+	{"✒️", "black nib", "Objects", "0.6"},
+	{"🖋️", "fountain pen", "Objects", "0.7"},
+	{"🖊️", "pen", "Objects", "0.7"},
+	{"🖌️", "paintbrush", "Objects", "0.7"},
+	{"🖍️", "crayon", "Objects", "0.7"},
+	{"📝", "memo", "Objects", "0.6"},
+	{"💼", "briefcase", "Objects", "0.6"},
+	{"📁", "file folder", "Objects", "0.6"},
+	{"📂", "open file folder", "Objects", "0.6"},
+	{"🗂️", "card index dividers", "Objects", "0.7"},
+	{"📅", "calendar", "Objects", "0.6"},
+	{"📆", "tear-off calendar", "Objects", "0.6"},
+	{"🗒️", "spiral notepad", "Objects", "0.7"},
+	{"🗓️", "spiral calendar", "Objects", "0.7"},
+	{"📇", "card index", "Objects", "0.6"},
+	{"📈", "chart increasing", "Objects", "0.6"},
+	{"📉", "chart decreasing", "Objects", "0.6"},
+	{"📊", "bar chart", "Objects", "0.6"},
+	{"📋", "clipboard", "Objects", "0.6"},
+	{"📌", "pushpin", "Objects", "0.6"},
+	{"📍", "round pushpin", "Objects", "0.6"},
+	{"📎", "paperclip", "Objects", "0.6"},
+	{"🖇️", "linked paperclips", "Objects", "0.7"},
+	{"📏", "straight ruler", "Objects", "0.6"},
+	{"📐", "triangular ruler", "Objects", "0.6"},
+	{"✂️", "scissors", "Objects", "0.6"},
+	{"🗃️", "card file box", "Objects", "0.7"},
+	{"🗄️", "file cabinet", "Objects", "0.7"},
+	{"🗑️", "wastebasket", "Objects", "0.7"},
+	{"🔒", "locked", "Objects", "0.6"},
+	{"🔓", "unlocked", "Objects", "0.6"},
+	{"🔏", "locked with pen", "Objects", "0.6"},
+	{"🔐", "locked with key", "Objects", "0.6"},
+	{"🔑", "key", "Objects", "0.6"},
+	{"🗝️", "old key", "Objects", "0.7"},
+	{"🔨", "hammer", "Objects", "0.6"},
+	{"🪓", "axe", "Objects", "12.0"},
+	{"⛏️", "pick", "Objects", "0.7"},
+	{"⚒️", "hammer and pick", "Objects", "1.0"},
+	{"🛠️", "hammer and wrench", "Objects", "0.7"},
+	{"🗡️", "dagger", "Objects", "0.7"},
+	{"⚔️", "crossed swords", "Objects", "1.0"},
+	{"💣", "bomb", "Objects", "0.6"},
+	{"🪃", "boomerang", "Objects", "13.0"},
+	{"🏹", "bow and arrow", "Objects", "1.0"},
+	{"🛡️", "shield", "Objects", "0.7"},
+	{"🪚", "carpentry saw", "Objects", "13.0"},
+	{"🔧", "wrench", "Objects", "0.6"},
+	{"🪛", "screwdriver", "Objects", "13.0"},
+	{"🔩", "nut and bolt", "Objects", "0.6"},
+	{"⚙️", "gear", "Objects", "1.0"},
+	{"🗜️", "clamp", "Objects", "0.7"},
+	{"⚖️", "balance scale", "Objects", "1.0"},
+	{"🦯", "white cane", "Objects", "12.0"},
+	{"🔗", "link", "Objects", "0.6"},
+	{"⛓️\u200d💥", "broken chain", "Objects", "15.1"},
+	{"⛓️", "chains", "Objects", "0.7"},
+	{"🪝", "hook", "Objects", "13.0"},
+	{"🧰", "toolbox", "Objects", "11.0"},
+	{"🧲", "magnet", "Objects", "11.0"},
+	{"🪜", "ladder", "Objects", "13.0"},
+	{"⚗️", "alembic", "Objects", "1.0"},
+	{"🧪", "test tube", "Objects", "11.0"},
+	{"🧫", "petri dish", "Objects", "11.0"},
+	{"🧬", "dna", "Objects", "11.0"},
+	{"🔬", "microscope", "Objects", "1.0"},
+	{"🔭", "telescope", "Objects", "1.0"},
+	{"📡", "satellite antenna", "Objects", "0.6"},
+	{"💉", "syringe", "Objects", "0.6"},
+	{"🩸", "drop of blood", "Objects", "12.0"},
+	{"💊", "pill", "Objects", "0.6"},
+	{"🩹", "adhesive bandage", "Objects", "12.0"},
+	{"🩼", "crutch", "Objects", "14.0"},
+	{"🩺", "stethoscope", "Objects", "12.0"},
+	{"🩻", "x-ray", "Objects", "14.0"},
+	{"🚪", "door", "Objects", "0.6"},
+	{"🛗", "elevator", "Objects", "13.0"},
+	{"🪞", "mirror", "Objects", "13.0"},
+	{"🪟", "window", "Objects", "13.0"},
+	{"🛏️", "bed", "Objects", "0.7"},
+	{"🛋️", "couch and lamp", "Objects", "0.7"},
+	{"🪑", "chair", "Objects", "12.0"},
+	{"🚽", "toilet", "Objects", "0.6"},
+	{"🪠", "plunger", "Objects", "13.0"},
+	{"🚿", "shower", "Objects", "1.0"},
+	{"🛁", "bathtub", "Objects", "1.0"},
+	{"🪤", "mouse trap", "Objects", "13.0"},
+	{"🪒", "razor", "Objects", "12.0"},
+	{"🧴", "lotion bottle", "Objects", "11.0"},
+	{"🧷", "safety pin", "Objects", "11.0"},
+	{"🧹", "broom", "Objects", "11.0"},
+	{"🧺", "basket", "Objects", "11.0"},
+	{"🧻", "roll of paper", "Objects", "11.0"},
+	{"🪣", "bucket", "Objects", "13.0"},
+	{"🧼", "soap", "Objects", "11.0"},
+	{"🫧", "bubbles", "Objects", "14.0"},
+	{"🪥", "toothbrush", "Objects", "13.0"},
+	{"🧽", "sponge", "Objects", "11.0"},
+	{"🧯", "fire extinguisher", "Objects", "11.0"},
+	{"🛒", "shopping cart", "Objects", "3.0"},
+	{"🚬", "cigarette", "Objects", "0.6"},
+	{"⚰️", "coffin", "Objects", "1.0"},
+	{"🪦", "headstone", "Objects", "13.0"},
+	{"⚱️", "funeral urn", "Objects", "1.0"},
+	{"🧿", "nazar amulet", "Objects", "11.0"},
+	{"🪬", "hamsa", "Objects", "14.0"},
+	{"🗿", "moai", "Objects", "0.6"},
+	{"🪧", "placard", "Objects", "13.0"},
+	{"🪪", "identification card", "Objects", "14.0"},
+	{"🏧", "ATM sign", "Symbols", "0.6"},
+	{"🚮", "litter in bin sign", "Symbols", "1.0"},
+	{"🚰", "potable water", "Symbols", "1.0"},
+	{"♿", "wheelchair symbol", "Symbols", "0.6"},
+	{"🚹", "men’s room", "Symbols", "0.6"},
+	{"🚺", "women’s room", "Symbols", "0.6"},
+	{"🚻", "restroom", "Symbols", "0.6"},
+	{"🚼", "baby symbol", "Symbols", "0.6"},
+	{"🚾", "water closet", "Symbols", "0.6"},
+	{"🛂", "passport control", "Symbols", "1.0"},
+	{"🛃", "customs", "Symbols", "1.0"},
+	{"🛄", "baggage claim", "Symbols", "1.0"},
+	{"🛅", "left luggage", "Symbols", "1.0"},
+	{"⚠️", "warning", "Symbols", "0.6"},
+	{"🚸", "children crossing", "Symbols", "1.0"},
+	{"⛔", "no entry", "Symbols", "0.6"},
+	{"🚫", "prohibited", "Symbols", "0.6"},
+	{"🚳", "no bicycles", "Symbols", "1.0"},
+	{"🚭", "no smoking", "Symbols", "0.6"},
+	{"🚯", "no littering", "Symbols", "1.0"},
+	{"🚱", "non-potable water", "Symbols", "1.0"},
+	{"🚷", "no pedestrians", "Symbols", "1.0"},
+	{"📵", "no mobile phones", "Symbols", "1.0"},
+	{"🔞", "no one under eighteen", "Symbols", "0.6"},
+	{"☢️", "radioactive", "Symbols", "1.0"},
+	{"☣️", "biohazard", "Symbols", "1.0"},
+	{"⬆️", "up arrow", "Symbols", "0.6"},
+	{"↗️", "up-right arrow", "Symbols", "0.6"},
+	{"➡️", "right arrow", "Symbols", "0.6"},
+	{"↘️", "down-right arrow", "Symbols", "0.6"},
+	{"⬇️", "down arrow", "Symbols", "0.6"},
+	{"↙️", "down-left arrow", "Symbols", "0.6"},
+	{"⬅️", "left arrow", "Symbols", "0.6"},
+	{"↖️", "up-left arrow", "Symbols", "0.6"},
+	{"↕️", "up-down arrow", "Symbols", "0.6"},
+	{"↔️", "left-right arrow", "Symbols", "0.6"},
+	{"↩️", "right arrow curving left", "Symbols", "0.6"},
+	{"↪️", "left arrow curving right", "Symbols", "0.6"},
+	{"⤴️", "right arrow curving up", "Symbols", "0.6"},
+	{"⤵️", "right arrow curving down", "Symbols", "0.6"},
+	{"🔃", "clockwise vertical arrows", "Symbols", "0.6"},
+	{"🔄", "counterclockwise arrows button", "Symbols", "1.0"},
+	{"🔙", "BACK arrow", "Symbols", "0.6"},
+	{"🔚", "END arrow", "Symbols", "0.6"},
+	{"🔛", "ON! arrow", "Symbols", "0.6"},
+	{"🔜", "SOON arrow", "Symbols", "0.6"},
+	{"🔝", "TOP arrow", "Symbols", "0.6"},
+	{"🛐", "place of worship", "Symbols", "1.0"},
+	{"⚛️", "atom symbol", "Symbols", "1.0"},
+	{"🕉️", "om", "Symbols", "0.7"},
+	{"✡️", "star of David", "Symbols", "0.7"},
+	{"☸️", "wheel of dharma", "Symbols", "0.7"},
+	{"☯️", "yin yang", "Symbols", "0.7"},
+	{"✝️", "latin cross", "Symbols", "0.7"},
+	{"☦️", "orthodox cross", "Symbols", "1.0"},
+	{"☪️", "star and crescent", "Symbols", "0.7"},
+	{"☮️", "peace symbol", "Symbols", "1.0"},
+	{"🕎", "menorah", "Symbols", "1.0"},
+	{"🔯", "dotted six-pointed star", "Symbols", "0.6"},
+	{"🪯", "khanda", "Symbols", "15.0"},
+	{"♈", "Aries", "Symbols", "0.6"},
+	{"♉", "Taurus", "Symbols", "0.6"},
+	{"♊", "Gemini", "Symbols", "0.6"},
+	{"♋", "Cancer", "Symbols", "0.6"},
+	{"♌", "Leo", "Symbols", "0.6"},
+	{"♍", "Virgo", "Symbols", "0.6"},
+	{"♎", "Libra", "Symbols", "0.6"},
+	{"♏", "Scorpio", "Symbols", "0.6"},
+	{"♐", "Sagittarius", "Symbols", "0.6"},
+	{"♑", "Capricorn", "Symbols", "0.6"},
+	{"♒", "Aquarius", "Symbols", "0.6"},
+	{"♓", "Pisces", "Symbols", "0.6"},
+	{"⛎", "Ophiuchus", "Symbols", "0.6"},
+	{"🔀", "shuffle tracks button", "Symbols", "1.0"},
+	{"🔁", "repeat button", "Symbols", "1.0"},
+	{"🔂", "repeat single button", "Symbols", "1.0"},
+	{"▶️", "play button", "Symbols", "0.6"},
+	{"⏩", "fast-forward button", "Symbols", "0.6"},
+	{"⏭️", "next track button", "Symbols", "0.7"},
+	{"⏯️", "play or pause button", "Symbols", "1.0"},
+	{"◀️", "reverse button", "Symbols", "0.6"},
+	{"⏪", "fast reverse button", "Symbols", "0.6"},
+	{"⏮️", "last track button", "Symbols", "0.7"},
+	{"🔼", "upwards button", "Symbols", "0.6"},
+	{"⏫", "fast up button", "Symbols", "0.6"},
+	{"🔽", "downwards button", "Symbols", "0.6"},
+	{"⏬", "fast down button", "Symbols", "0.6"},
+	{"⏸️", "pause button", "Symbols", "0.7"},
+	{"⏹️", "stop button", "Symbols", "0.7"},
+	{"⏺️", "record button", "Symbols", "0.7"},
+	{"⏏️", "eject button", "Symbols", "1.0"},
+	{"🎦", "cinema", "Symbols", "0.6"},
+	{"🔅", "dim button", "Symbols", "1.0"},
+	{"🔆", "bright button", "Symbols", "1.0"},
+	{"📶", "antenna bars", "Symbols", "0.6"},
+	{"🛜", "wireless", "Symbols", "15.0"},
+	{"📳", "vibration mode", "Symbols", "0.6"},
+	{"📴", "mobile phone off", "Symbols", "0.6"},
+	{"♀️", "female sign", "Symbols", "4.0"},
+	{"♂️", "male sign", "Symbols", "4.0"},
+	{"⚧️", "transgender symbol", "Symbols", "13.0"},
+	{"✖️", "multiply", "Symbols", "0.6"},
+	{"➕", "plus", "Symbols", "0.6"},
+	{"➖", "minus", "Symbols", "0.6"},
+	{"➗", "divide", "Symbols", "0.6"},
+	{"🟰", "heavy equals sign", "Symbols", "14.0"},
+	{"♾️", "infinity", "Symbols", "11.0"},
+	{"‼️", "double exclamation mark", "Symbols", "0.6"},
+	{"⁉️", "exclamation question mark", "Symbols", "0.6"},
+	{"❓", "red question mark", "Symbols", "0.6"},
+	{"❔", "white question mark", "Symbols", "0.6"},
+	{"❕", "white exclamation mark", "Symbols", "0.6"},
+	{"❗", "red exclamation mark", "Symbols", "0.6"},
+	{"〰️", "wavy dash", "Symbols", "0.6"},
+	{"💱", "currency exchange", "Symbols", "0.6"},
+	{"💲", "heavy dollar sign", "Symbols", "0.6"},
+	{"⚕️", "medical symbol", "Symbols", "4.0"},
+	{"♻️", "recycling symbol", "Symbols", "0.6"},
+	{"⚜️", "fleur-de-lis", "Symbols", "1.0"},
+	{"🔱", "trident emblem", "Symbols", "0.6"},
+	{"📛", "name badge", "Symbols", "0.6"},
+	{"🔰", "Japanese symbol for beginner", "Symbols", "0.6"},
+	{"⭕", "hollow red circle", "Symbols", "0.6"},
+	{"✅", "check mark button", "Symbols", "0.6"},
+	{"☑️", "check box with check", "Symbols", "0.6"},
+	{"✔️", "check mark", "Symbols", "0.6"},
+	{"❌", "cross mark", "Symbols", "0.6"},
+	{"❎", "cross mark button", "Symbols", "0.6"},
+	{"➰", "curly loop", "Symbols", "0.6"},
+	{"➿", "double curly loop", "Symbols", "1.0"},
+	{"〽️", "part alternation mark", "Symbols", "0.6"},
+	{"✳️", "eight-spoked asterisk", "Symbols", "0.6"},
+	{"✴️", "eight-pointed star", "Symbols", "0.6"},
+	{"❇️", "sparkle", "Symbols", "0.6"},
+	{"©️", "copyright", "Symbols", "0.6"},
+	{"®️", "registered", "Symbols", "0.6"},
+	{"™️", "trade mark", "Symbols", "0.6"},
+	{"#️⃣", "keycap: #", "Symbols", "0.6"},
+	{"*️⃣", "keycap: *", "Symbols", "2.0"},
+	{"0️⃣", "keycap: 0", "Symbols", "0.6"},
+	{"1️⃣", "keycap: 1", "Symbols", "0.6"},
+	{"2️⃣", "keycap: 2", "Symbols", "0.6"},
+	{"3️⃣", "keycap: 3", "Symbols", "0.6"},
+	{"4️⃣", "keycap: 4", "Symbols", "0.6"},
+	{"5️⃣", "keycap: 5", "Symbols", "0.6"},
+	{"6️⃣", "keycap: 6", "Symbols", "0.6"},
+	{"7️⃣", "keycap: 7", "Symbols", "0.6"},
+	{"8️⃣", "keycap: 8", "Symbols", "0.6"},
+	{"9️⃣", "keycap: 9", "Symbols", "0.6"},
+	{"🔟", "keycap: 10", "Symbols", "0.6"},
+	{"🔠", "input latin uppercase", "Symbols", "0.6"},
+	{"🔡", "input latin lowercase", "Symbols", "0.6"},
+	{"🔢", "input numbers", "Symbols", "0.6"},
+	{"🔣", "input symbols", "Symbols", "0.6"},
+	{"🔤", "input latin letters", "Symbols", "0.6"},
+	{"🅰️", "A button (blood type)", "Symbols", "0.6"},
+	{"🆎", "AB button (blood type)", "Symbols", "0.6"},
+	{"🅱️", "B button (blood type)", "Symbols", "0.6"},
+	{"🆑", "CL button", "Symbols", "0.6"},
+	{"🆒", "COOL button", "Symbols", "0.6"},
+	{"🆓", "FREE button", "Symbols", "0.6"},
+	{"ℹ️", "information", "Symbols", "0.6"},
+	{"🆔", "ID button", "Symbols", "0.6"},
+	{"Ⓜ️", "circled M", "Symbols", "0.6"},
+	{"🆕", "NEW button", "Symbols", "0.6"},
+	{"🆖", "NG button", "Symbols", "0.6"},
+	{"🅾️", "O button (blood type)", "Symbols", "0.6"},
+	{"🆗", "OK button", "Symbols", "0.6"},
+	{"🅿️", "P button", "Symbols", "0.6"},
+	{"🆘", "SOS button", "Symbols", "0.6"},
+	{"🆙", "UP! button", "Symbols", "0.6"},
+	{"🆚", "VS button", "Symbols", "0.6"},
+	{"🈁", "Japanese “here” button", "Symbols", "0.6"},
+	{"🈂️", "Japanese “service charge” button", "Symbols", "0.6"},
+	{"🈷️", "Japanese “monthly amount” button", "Symbols", "0.6"},
+	{"🈶", "Japanese “not free of charge” button", "Symbols", "0.6"},
+	{"🈯", "Japanese “reserved” button", "Symbols", "0.6"},
+	{"🉐", "Japanese “bargain” button", "Symbols", "0.6"},
+	{"🈹", "Japanese “discount” button", "Symbols", "0.6"},
+	{"🈚", "Japanese “free of charge” button", "Symbols", "0.6"},
+	{"🈲", "Japanese “prohibited” button", "Symbols", "0.6"},
+	{"🉑", "Japanese “acceptable” button", "Symbols", "0.6"},
+	{"🈸", "Japanese “application” button", "Symbols", "0.6"},
+	{"🈴", "Japanese “passing grade” button", "Symbols", "0.6"},
+	{"🈳", "Japanese “vacancy” button", "Symbols", "0.6"},
+	{"㊗️", "Japanese “congratulations” button", "Symbols", "0.6"},
+	{"㊙️", "Japanese “secret” button", "Symbols", "0.6"},
+	{"🈺", "Japanese “open for business” button", "Symbols", "0.6"},
+	{"🈵", "Japanese “no vacancy” button", "Symbols", "0.6"},
+	{"🔴", "red circle", "Symbols", "0.6"},
+	{"🟠", "orange circle", "Symbols", "12.0"},
+	{"🟡", "yellow circle", "Symbols", "12.0"},
+	{"🟢", "green circle", "Symbols", "12.0"},
+	{"🔵", "blue circle", "Symbols", "0.6"},
+	{"🟣", "purple circle", "Symbols", "12.0"},
+	{"🟤", "brown circle", "Symbols", "12.0"},
+	{"⚫", "black circle", "Symbols", "0.6"},
+	{"⚪", "white circle", "Symbols", "0.6"},
+	{"🟥", "red square", "Symbols", "12.0"},
+	{"🟧", "orange square", "Symbols", "12.0"},
+	{"🟨", "yellow square", "Symbols", "12.0"},
+	{"🟩", "green square", "Symbols", "12.0"},
+	{"🟦", "blue square", "Symbols", "12.0"},
+	{"🟪", "purple square", "Symbols", "12.0"},
+	{"🟫", "brown square", "Symbols", "12.0"},
+	{"⬛", "black large square", "Symbols", "0.6"},
+	{"⬜", "white large square", "Symbols", "0.6"},
+	{"◼️", "black medium square", "Symbols", "0.6"},
+	{"◻️", "white medium square", "Symbols", "0.6"},
+	{"◾", "black medium-small square", "Symbols", "0.6"},
+	{"◽", "white medium-small square", "Symbols", "0.6"},
+	{"▪️", "black small square", "Symbols", "0.6"},
+	{"▫️", "white small square", "Symbols", "0.6"},
+	{"🔶", "large orange diamond", "Symbols", "0.6"},
+	{"🔷", "large blue diamond", "Symbols", "0.6"},
+	{"🔸", "small orange diamond", "Symbols", "0.6"},
+	{"🔹", "small blue diamond", "Symbols", "0.6"},
+	{"🔺", "red triangle pointed up", "Symbols", "0.6"},
+	{"🔻", "red triangle pointed down", "Symbols", "0.6"},
+	{"💠", "diamond with a dot", "Symbols", "0.6"},
+	{"🔘", "radio button", "Symbols", "0.6"},
+	{"🔳", "white square button", "Symbols", "0.6"},
+	{"🔲", "black square button", "Symbols", "0.6"},
+	{"🏁", "chequered flag", "Flags", "0.6"},
+	{"🚩", "triangular flag", "Flags", "0.6"},
+	{"🎌", "crossed flags", "Flags", "0.6"},
+	{"🏴", "black flag", "Flags", "1.0"},
+	{"🏳️", "white flag", "Flags", "0.7"},
+	{"🏳️\u200d🌈", "rainbow flag", "Flags", "4.0"},
+	{"🏳️\u200d⚧️", "transgender flag", "Flags", "13.0"},
+	{"🏴\u200d☠️", "pirate flag", "Flags", "11.0"},
+	{"🇦🇨", "flag: Ascension Island", "Flags", "2.0"},
+	{"🇦🇩", "flag: Andorra", "Flags", "2.0"},
+	{"🇦🇪", "flag: United Arab Emirates", "Flags", "2.0"},
+	{"🇦🇫", "flag: Afghanistan", "Flags", "2.0"},
+	{"🇦🇬", "flag: Antigua & Barbuda", "Flags", "2.0"},
+	{"🇦🇮", "flag: Anguilla", "Flags", "2.0"},
+	{"🇦🇱", "flag: Albania", "Flags", "2.0"},
+	{"🇦🇲", "flag: Armenia", "Flags", "2.0"},
+	{"🇦🇴", "flag: Angola", "Flags", "2.0"},
+	{"🇦🇶", "flag: Antarctica", "Flags", "2.0"},
+	{"🇦🇷", "flag: Argentina", "Flags", "2.0"},
+	{"🇦🇸", "flag: American Samoa", "Flags", "2.0"},
+	{"🇦🇹", "flag: Austria", "Flags", "2.0"},
+	{"🇦🇺", "flag: Australia", "Flags", "2.0"},
+	{"🇦🇼", "flag: Aruba", "Flags", "2.0"},
+	{"🇦🇽", "flag: Åland Islands", "Flags", "2.0"},
+	{"🇦🇿", "flag: Azerbaijan", "Flags", "2.0"},
+	{"🇧🇦", "flag: Bosnia & Herzegovina", "Flags", "2.0"},
+	{"🇧🇧", "flag: Barbados", "Flags", "2.0"},
+	{"🇧🇩", "flag: Bangladesh", "Flags", "2.0"},
+	{"🇧🇪", "flag: Belgium", "Flags", "2.0"},
+	{"🇧🇫", "flag: Burkina Faso", "Flags", "2.0"},
+	{"🇧🇬", "flag: Bulgaria", "Flags", "2.0"},
+	{"🇧🇭", "flag: Bahrain", "Flags", "2.0"},
+	{"🇧🇮", "flag: Burundi", "Flags", "2.0"},
+	{"🇧🇯", "flag: Benin", "Flags", "2.0"},
+	{"🇧🇱", "flag: St. Barthélemy", "Flags", "2.0"},
+	{"🇧🇲", "flag: Bermuda", "Flags", "2.0"},
+	{"🇧🇳", "flag: Brunei", "Flags", "2.0"},
+	{"🇧🇴", "flag: Bolivia", "Flags", "2.0"},
+	{"🇧🇶", "flag: Caribbean Netherlands", "Flags", "2.0"},
+	{"🇧🇷", "flag: Brazil", "Flags", "2.0"},
+	{"🇧🇸", "flag: Bahamas", "Flags", "2.0"},
+	{"🇧🇹", "flag: Bhutan", "Flags", "2.0"},
+	{"🇧🇻", "flag: Bouvet Island", "Flags", "2.0"},
+	{"🇧🇼", "flag: Botswana", "Flags", "2.0"},
+	{"🇧🇾", "flag: Belarus", "Flags", "2.0"},
+	{"🇧🇿", "flag: Belize", "Flags", "2.0"},
+	{"🇨🇦", "flag: Canada", "Flags", "2.0"},
+	{"🇨🇨", "flag: Cocos (Keeling) Islands", "Flags", "2.0"},
+	{"🇨🇩", "flag: Congo - Kinshasa", "Flags", "2.0"},
+	{"🇨🇫", "flag: Central African Republic", "Flags", "2.0"},
+	{"🇨🇬", "flag: Congo - Brazzaville", "Flags", "2.0"},
+	{"🇨🇭", "flag: Switzerland", "Flags", "2.0"},
+	{"🇨🇮", "flag: Côte d’Ivoire", "Flags", "2.0"},
+	{"🇨🇰", "flag: Cook Islands", "Flags", "2.0"},
+	{"🇨🇱", "flag: Chile", "Flags", "2.0"},
+	{"🇨🇲", "flag: Cameroon", "Flags", "2.0"},
+	{"🇨🇳", "flag: China", "Flags", "0.6"},
+	{"🇨🇴", "flag: Colombia", "Flags", "2.0"},
+	{"🇨🇵", "flag: Clipperton Island", "Flags", "2.0"},
+	{"🇨🇷", "flag: Costa Rica", "Flags", "2.0"},
+	{"🇨🇺", "flag: Cuba", "Flags", "2.0"},
+	{"🇨🇻", "flag: Cape Verde", "Flags", "2.0"},
+	{"🇨🇼", "flag: Curaçao", "Flags", "2.0"},
+	{"🇨🇽", "flag: Christmas Island", "Flags", "2.0"},
+	{"🇨🇾", "flag: Cyprus", "Flags", "2.0"},
+	{"🇨🇿", "flag: Czechia", "Flags", "2.0"},
+	{"🇩🇪", "flag: Germany", "Flags", "0.6"},
+	{"🇩🇬", "flag: Diego Garcia", "Flags", "2.0"},
+	{"🇩🇯", "flag: Djibouti", "Flags", "2.0"},
+	{"🇩🇰", "flag: Denmark", "Flags", "2.0"},
+	{"🇩🇲", "flag: Dominica", "Flags", "2.0"},
+	{"🇩🇴", "flag: Dominican Republic", "Flags", "2.0"},
+	{"🇩🇿", "flag: Algeria", "Flags", "2.0"},
+	{"🇪🇦", "flag: Ceuta & Melilla", "Flags", "2.0"},
+	{"🇪🇨", "flag: Ecuador", "Flags", "2.0"},
+	{"🇪🇪", "flag: Estonia", "Flags", "2.0"},
+	{"🇪🇬", "flag: Egypt", "Flags", "2.0"},
+	{"🇪🇭", "flag: Western Sahara", "Flags", "2.0"},
+	{"🇪🇷", "flag: Eritrea", "Flags", "2.0"},
+	{"🇪🇸", "flag: Spain", "Flags", "0.6"},
+	{"🇪🇹", "flag: Ethiopia", "Flags", "2.0"},
+	{"🇪🇺", "flag: European Union", "Flags", "2.0"},
+	{"🇫🇮", "flag: Finland", "Flags", "2.0"},
+	{"🇫🇯", "flag: Fiji", "Flags", "2.0"},
+	{"🇫🇰", "flag: Falkland Islands", "Flags", "2.0"},
+	{"🇫🇲", "flag: Micronesia", "Flags", "2.0"},
+	{"🇫🇴", "flag: Faroe Islands", "Flags", "2.0"},
+	{"🇫🇷", "flag: France", "Flags", "0.6"},
+	{"🇬🇦", "flag: Gabon", "Flags", "2.0"},
+	{"🇬🇧", "flag: United Kingdom", "Flags", "0.6"},
+	{"🇬🇩", "flag: Grenada", "Flags", "2.0"},
+	{"🇬🇪", "flag: Georgia", "Flags", "2.0"},
+	{"🇬🇫", "flag: French Guiana", "Flags", "2.0"},
+	{"🇬🇬", "flag: Guernsey", "Flags", "2.0"},
+	{"🇬🇭", "flag: Ghana", "Flags", "2.0"},
+	{"🇬🇮", "flag: Gibraltar", "Flags", "2.0"},
+	{"🇬🇱", "flag: Greenland", "Flags", "2.0"},
+	{"🇬🇲", "flag: Gambia", "Flags", "2.0"},
+	{"🇬🇳", "flag: Guinea", "Flags", "2.0"},
+	{"🇬🇵", "flag: Guadeloupe", "Flags", "2.0"},
+	{"🇬🇶", "flag: Equatorial Guinea", "Flags", "2.0"},
+	{"🇬🇷", "flag: Greece", "Flags", "2.0"},
+	{"🇬🇸", "flag: South Georgia & South Sandwich Islands", "Flags", "2.0"},
+	{"🇬🇹", "flag: Guatemala", "Flags", "2.0"},
+	{"🇬🇺", "flag: Guam", "Flags", "2.0"},
+	{"🇬🇼", "flag: Guinea-Bissau", "Flags", "2.0"},
+	{"🇬🇾", "flag: Guyana", "Flags", "2.0"},
+	{"🇭🇰", "flag: Hong Kong SAR China", "Flags", "2.0"},
+	{"🇭🇲", "flag: Heard & McDonald Islands", "Flags", "2.0"},
+	{"🇭🇳", "flag: Honduras", "Flags", "2.0"},
+	{"🇭🇷", "flag: Croatia", "Flags", "2.0"},
+	{"🇭🇹", "flag: Haiti", "Flags", "2.0"},
+	{"🇭🇺", "flag: Hungary", "Flags", "2.0"},
+	{"🇮🇨", "flag: Canary Islands", "Flags", "2.0"},
+	{"🇮🇩", "flag: Indonesia", "Flags", "2.0"},
+	{"🇮🇪", "flag: Ireland", "Flags", "2.0"},
+	{"🇮🇱", "flag: Israel", "Flags", "2.0"},
+	{"🇮🇲", "flag: Isle of Man", "Flags", "2.0"},
+	{"🇮🇳", "flag: India", "Flags", "2.0"},
+	{"🇮🇴", "flag: British Indian Ocean Territory", "Flags", "2.0"},
+	{"🇮🇶", "flag: Iraq", "Flags", "2.0"},
+	{"🇮🇷", "flag: Iran", "Flags", "2.0"},
+	{"🇮🇸", "flag: Iceland", "Flags", "2.0"},
+	{"🇮🇹", "flag: Italy", "Flags", "0.6"},
+	{"🇯🇪", "flag: Jersey", "Flags", "2.0"},
+	{"🇯🇲", "flag: Jamaica", "Flags", "2.0"},
+	{"🇯🇴", "flag: Jordan", "Flags", "2.0"},
+	{"🇯🇵", "flag: Japan", "Flags", "0.6"},
+	{"🇰🇪", "flag: Kenya", "Flags", "2.0"},
+	{"🇰🇬", "flag: Kyrgyzstan", "Flags", "2.0"},
+	{"🇰🇭", "flag: Cambodia", "Flags", "2.0"},
+	{"🇰🇮", "flag: Kiribati", "Flags", "2.0"},
+	{"🇰🇲", "flag: Comoros", "Flags", "2.0"},
+	{"🇰🇳", "flag: St. Kitts & Nevis", "Flags", "2.0"},
+	{"🇰🇵", "flag: North Korea", "Flags", "2.0"},
+	{"🇰🇷", "flag: South Korea", "Flags", "0.6"},
+	{"🇰🇼", "flag: Kuwait", "Flags", "2.0"},
+	{"🇰🇾", "flag: Cayman Islands", "Flags", "2.0"},
+	{"🇰🇿", "flag: Kazakhstan", "Flags", "2.0"},
+	{"🇱🇦", "flag: Laos", "Flags", "2.0"},
+	{"🇱🇧", "flag: Lebanon", "Flags", "2.0"},
+	{"🇱🇨", "flag: St. Lucia", "Flags", "2.0"},
+	{"🇱🇮", "flag: Liechtenstein", "Flags", "2.0"},
+	{"🇱🇰", "flag: Sri Lanka", "Flags", "2.0"},
+	{"🇱🇷", "flag: Liberia", "Flags", "2.0"},
+	{"🇱🇸", "flag: Lesotho", "Flags", "2.0"},
+	{"🇱🇹", "flag: Lithuania", "Flags", "2.0"},
+	{"🇱🇺", "flag: Luxembourg", "Flags", "2.0"},
+	{"🇱🇻", "flag: Latvia", "Flags", "2.0"},
+	{"🇱🇾", "flag: Libya", "Flags", "2.0"},
+	{"🇲🇦", "flag: Morocco", "Flags", "2.0"},
+	{"🇲🇨", "flag: Monaco", "Flags", "2.0"},
+	{"🇲🇩", "flag: Moldova", "Flags", "2.0"},
+	{"🇲🇪", "flag: Montenegro", "Flags", "2.0"},
+	{"🇲🇫", "flag: St. Martin", "Flags", "2.0"},
+	{"🇲🇬", "flag: Madagascar", "Flags", "2.0"},
+	{"🇲🇭", "flag: Marshall Islands", "Flags", "2.0"},
+	{"🇲🇰", "flag: North Macedonia", "Flags", "2.0"},
+	{"🇲🇱", "flag: Mali", "Flags", "2.0"},
+	{"🇲🇲", "flag: Myanmar (Burma)", "Flags", "2.0"},
+	{"🇲🇳", "flag: Mongolia", "Flags", "2.0"},
+	{"🇲🇴", "flag: Macao SAR China", "Flags", "2.0"},
+	{"🇲🇵", "flag: Northern Mariana Islands", "Flags", "2.0"},
+	{"🇲🇶", "flag: Martinique", "Flags", "2.0"},
+	{"🇲🇷", "flag: Mauritania", "Flags", "2.0"},
+	{"🇲🇸", "flag: Montserrat", "Flags", "2.0"},
+	{"🇲🇹", "flag: Malta", "Flags", "2.0"},
+	{"🇲🇺", "flag: Mauritius", "Flags", "2.0"},
+	{"🇲🇻", "flag: Maldives", "Flags", "2.0"},
+	{"🇲🇼", "flag: Malawi", "Flags", "2.0"},
+	{"🇲🇽", "flag: Mexico", "Flags", "2.0"},
+	{"🇲🇾", "flag: Malaysia", "Flags", "2.0"},
+	{"🇲🇿", "flag: Mozambique", "Flags", "2.0"},
+	{"🇳🇦", "flag: Namibia", "Flags", "2.0"},
+	{"🇳🇨", "flag: New Caledonia", "Flags", "2.0"},
+	{"🇳🇪", "flag: Niger", "Flags", "2.0"},
+	{"🇳🇫", "flag: Norfolk Island", "Flags", "2.0"},
+	{"🇳🇬", "flag: Nigeria", "Flags", "2.0"},
+	{"🇳🇮", "flag: Nicaragua", "Flags", "2.0"},
+	{"🇳🇱", "flag: Netherlands", "Flags", "2.0"},
+	{"🇳🇴", "flag: Norway", "Flags", "2.0"},
+	{"🇳🇵", "flag: Nepal", "Flags", "2.0"},
+	{"🇳🇷", "flag: Nauru", "Flags", "2.0"},
+	{"🇳🇺", "flag: Niue", "Flags", "2.0"},
+	{"🇳🇿", "flag: New Zealand", "Flags", "2.0"},
+	{"🇴🇲", "flag: Oman", "Flags", "2.0"},
+	{"🇵🇦", "flag: Panama", "Flags", "2.0"},
+	{"🇵🇪", "flag: Peru", "Flags", "2.0"},
+	{"🇵🇫", "flag: French Polynesia", "Flags", "2.0"},
+	{"🇵🇬", "flag: Papua New Guinea", "Flags", "2.0"},
+	{"🇵🇭", "flag: Philippines", "Flags", "2.0"},
+	{"🇵🇰", "flag: Pakistan", "Flags", "2.0"},
+	{"🇵🇱", "flag: Poland", "Flags", "2.0"},
+	{"🇵🇲", "flag: St. Pierre & Miquelon", "Flags", "2.0"},
+	{"🇵🇳", "flag: Pitcairn Islands", "Flags", "2.0"},
+	{"🇵🇷", "flag: Puerto Rico", "Flags", "2.0"},
+	{"🇵🇸", "flag: Palestinian Territories", "Flags", "2.0"},
+	{"🇵🇹", "flag: Portugal", "Flags", "2.0"},
+	{"🇵🇼", "flag: Palau", "Flags", "2.0"},
+	{"🇵🇾", "flag: Paraguay", "Flags", "2.0"},
+	{"🇶🇦", "flag: Qatar", "Flags", "2.0"},
+	{"🇷🇪", "flag: Réunion", "Flags", "2.0"},
+	{"🇷🇴", "flag: Romania", "Flags", "2.0"},
+	{"🇷🇸", "flag: Serbia", "Flags", "2.0"},
+	{"🇷🇺", "flag: Russia", "Flags", "0.6"},
+	{"🇷🇼", "flag: Rwanda", "Flags", "2.0"},
+	{"🇸🇦", "flag: Saudi Arabia", "Flags", "2.0"},
+	{"🇸🇧", "flag: Solomon Islands", "Flags", "2.0"},
+	{"🇸🇨", "flag: Seychelles", "Flags", "2.0"},
+	{"🇸🇩", "flag: Sudan", "Flags", "2.0"},
+	{"🇸🇪", "flag: Sweden", "Flags", "2.0"},
+	{"🇸🇬", "flag: Singapore", "Flags", "2.0"},
+	{"🇸🇭", "flag: St. Helena", "Flags", "2.0"},
+	{"🇸🇮", "flag: Slovenia", "Flags", "2.0"},
+	{"🇸🇯", "flag: Svalbard & Jan Mayen", "Flags", "2.0"},
+	{"🇸🇰", "flag: Slovakia", "Flags", "2.0"},
+	{"🇸🇱", "flag: Sierra Leone", "Flags", "2.0"},
+	{"🇸🇲", "flag: San Marino", "Flags", "2.0"},
+	{"🇸🇳", "flag: Senegal", "Flags", "2.0"},
+	{"🇸🇴", "flag: Somalia", "Flags", "2.0"},
+	{"🇸🇷", "flag: Suriname", "Flags", "2.0"},
+	{"🇸🇸", "flag: South Sudan", "Flags", "2.0"},
+	{"🇸🇹", "flag: São Tomé & Príncipe", "Flags", "2.0"},
+	{"🇸🇻", "flag: El Salvador", "Flags", "2.0"},
+	{"🇸🇽", "flag: Sint Maarten", "Flags", "2.0"},
+	{"🇸🇾", "flag: Syria", "Flags", "2.0"},
+	{"🇸🇿", "flag: Eswatini", "Flags", "2.0"},
+	{"🇹🇦", "flag: Tristan da Cunha", "Flags", "2.0"},
+	{"🇹🇨", "flag: Turks & Caicos Islands", "Flags", "2.0"},
+	{"🇹🇩", "flag: Chad", "Flags", "2.0"},
+	{"🇹🇫", "flag: French Southern Territories", "Flags", "2.0"},
+	{"🇹🇬", "flag: Togo", "Flags", "2.0"},
+	{"🇹🇭", "flag: Thailand", "Flags", "2.0"},
+	{"🇹🇯", "flag: Tajikistan", "Flags", "2.0"},
+	{"🇹🇰", "flag: Tokelau", "Flags", "2.0"},
+	{"🇹🇱", "flag: Timor-Leste", "Flags", "2.0"},
+	{"🇹🇲", "flag: Turkmenistan", "Flags", "2.0"},
+	{"🇹🇳", "flag: Tunisia", "Flags", "2.0"},
+	{"🇹🇴", "flag: Tonga", "Flags", "2.0"},
+	{"🇹🇷", "flag: Türkiye", "Flags", "2.0"},
+	{"🇹🇹", "flag: Trinidad & Tobago", "Flags", "2.0"},
+	{"🇹🇻", "flag: Tuvalu", "Flags", "2.0"},
+	{"🇹🇼", "flag: Taiwan", "Flags", "2.0"},
+	{"🇹🇿", "flag: Tanzania", "Flags", "2.0"},
+	{"🇺🇦", "flag: Ukraine", "Flags", "2.0"},
+	{"🇺🇬", "flag: Uganda", "Flags", "2.0"},
+	{"🇺🇲", "flag: U.S. Outlying Islands", "Flags", "2.0"},
+	{"🇺🇳", "flag: United Nations", "Flags", "4.0"},
+	{"🇺🇸", "flag: United States", "Flags", "0.6"},
+	{"🇺🇾", "flag: Uruguay", "Flags", "2.0"},
+	{"🇺🇿", "flag: Uzbekistan", "Flags", "2.0"},
+	{"🇻🇦", "flag: Vatican City", "Flags", "2.0"},
+	{"🇻🇨", "flag: St. Vincent & Grenadines", "Flags", "2.0"},
+	{"🇻🇪", "flag: Venezuela", "Flags", "2.0"},
+	{"🇻🇬", "flag: British Virgin Islands", "Flags", "2.0"},
+	{"🇻🇮", "flag: U.S. Virgin Islands", "Flags", "2.0"},
+	{"🇻🇳", "flag: Vietnam", "Flags", "2.0"},
+	{"🇻🇺", "flag: Vanuatu", "Flags", "2.0"},
+	{"🇼🇫", "flag: Wallis & Futuna", "Flags", "2.0"},
+	{"🇼🇸", "flag: Samoa", "Flags", "2.0"},
+	{"🇽🇰", "flag: Kosovo", "Flags", "2.0"},
+	{"🇾🇪", "flag: Yemen", "Flags", "2.0"},
+	{"🇾🇹", "flag: Mayotte", "Flags", "2.0"},
+	{"🇿🇦", "flag: South Africa", "Flags", "2.0"},
+	{"🇿🇲", "flag: Zambia", "Flags", "2.0"},
+	{"🇿🇼", "flag: Zimbabwe", "Flags", "2.0"},
+	{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "flag: England", "Flags", "5.0"},
+	{"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "flag: Scotland", "Flags", "5.0"},
+	{"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "flag: Wales", "Flags", "5.0"},
+}
@@ -0,0 +1,102 @@
+//go:build ignore
+
+// gen_emoji generates emoji_data.go from data/emoji-test.txt. To upgrade the
+// Unicode version, replace data/emoji-test.txt with the file from
+// https://unicode.org/Public/emoji/latest/emoji-test.txt and run:
+//
+//	go generate ./...
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	inputFile  = "data/emoji-test.txt"
+	outputFile = "emoji_data.go"
+)
+
+// 1F600 ; fully-qualified # 😀 E1.0 grinning face
+var reLine = regexp.MustCompile(`^([0-9A-F ]+);\s*([a-z-]+)\s*#\s*\S+\s+E(\d+\.\d+)\s+(.+)$`)
+
+func main() {
+	data, err := os.ReadFile(inputFile)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_emoji.go from " + inputFile + "; DO NOT EDIT.\n\n")
+	b.WriteString("package main\n\n")
+
+	var version, group string
+	var rows []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "# Version:"):
+			version = strings.TrimSpace(strings.TrimPrefix(line, "# Version:"))
+			continue
+		case strings.HasPrefix(line, "# group:"):
+			group = strings.TrimSpace(strings.TrimPrefix(line, "# group:"))
+			continue
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		}
+
+		m := reLine.FindStringSubmatch(line)
+		if m == nil {
+			fatalf("unexpected line: %q", line)
+		}
+		status := m[2]
+		if status != "fully-qualified" && status != "component" {
+			continue // the other forms only differ by U+FE0F
+		}
+		var emoji strings.Builder
+		for _, cp := range strings.Fields(m[1]) {
+			r, err := strconv.ParseUint(cp, 16, 32)
+			if err != nil {
+				fatalf("invalid code point %q: %v", cp, err)
+			}
+			emoji.WriteRune(rune(r))
+		}
+		rows = append(rows, fmt.Sprintf("{%q, %q, %q, %q},", emoji.String(), m[4], group, m[3]))
+	}
+	if err := scanner.Err(); err != nil {
+		fatalf("%v", err)
+	}
+	if version == "" {
+		fatalf("missing version in %v", inputFile)
+	}
+
+	fmt.Fprintf(&b, "// unicodeEmojiVersion is the version of %v\n", inputFile)
+	fmt.Fprintf(&b, "const unicodeEmojiVersion = %q\n\n", version)
+	b.WriteString("// emojiTable lists the fully-qualified emojis and the components\n")
+	b.WriteString("var emojiTable = []emojiInfo{\n")
+	for _, row := range rows {
+		b.WriteString(row + "\n")
+	}
+	b.WriteString("}\n")
+
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		fatalf("formatting: %v", err)
+	}
+	if err = os.WriteFile(outputFile, out, 0644); err != nil {
+		fatalf("%v", err)
+	}
+	fmt.Printf("generated %v: %d emojis, Unicode %v\n", outputFile, len(rows), version)
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "gen_emoji: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"strings"
+	"time"
+)
+
+// Commit is a commit read from git log, classified by its leading emoji
+type Commit struct {
+	Hash    string    `json:"hash"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+
+	Emoji string  `json:"emoji"` // the emojis of the subject, empty if there is none
+	Type  *Type   `json:"-"`     // the type of the primary emoji, nil if it has none
+	Types []*Type `json:"-"`     // the known types of all the emojis, with secondary tags
+}
+
+func (c *Commit) ShortHash() string { return shortSha(c.Hash) }
+
+// subjectWithoutEmoji returns the subject without its emoji
+func (c *Commit) subjectWithoutEmoji() string {
+	if c.Emoji == "" {
+		return c.Subject
+	}
+	if settings.EmojiPosition == positionEnd {
+		return strings.TrimSpace(strings.TrimSuffix(c.Subject, c.Emoji))
+	}
+	prefix, rest := cutEmojiPrefix(c.Subject)
+	return prefix + strings.TrimSpace(strings.TrimPrefix(rest, c.Emoji))
+}
+
+// TypeGroup is the commits of the same type
+type TypeGroup struct {
+	Type    *Type     `json:"type"`
+	Commits []*Commit `json:"commits"`
+}
+
+// uncategorized groups the commits without a known emoji
+var uncategorized = &Type{Name: "Uncategorized"}
+
+const (
+	logFieldSep  = "\x1f"
+	logRecordSep = "\x1e"
+)
+
+// gitLog reads the commits with "git log <args>", newest first
+func gitLog(args ...string) []*Commit {
+	format := strings.Join([]string{"%H", "%aN", "%aE", "%aI", "%s", "%b"}, logFieldSep) + logRecordSep
+	args = append([]string{"log", "--use-mailmap", "--format=" + format}, args...)
+	out, errStr, err := execGitx(args...)
+	if err != nil {
+		fatalf("git log: %v\n%s", err, errStr)
+	}
+
+	var commits []*Commit
+	for _, record := range strings.Split(out, logRecordSep) {
+		record = strings.TrimSpace(record)
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, logFieldSep, 6)
+		if len(fields) != 6 {
+			fatalf("unexpected git log output: %q", record)
+		}
+		c := &Commit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Email:   fields[2],
+			Subject: fields[4],
+			Body:    strings.TrimSpace(fields[5]),
+		}
+		c.Date, _ = time.Parse(time.RFC3339, fields[3])
+		c.Emoji, c.Type, c.Types = classify(c.Subject)
+		commits = append(commits, c)
+	}
+	return commits
+}
+
+// classify returns the emojis of a subject, the type of the primary emoji
+// and the types of all the emojis
+func classify(subject string) (emoji string, typ *Type, types []*Type) {
+	subject = stripAutosquashPrefixes(subject)
+	emojis := subjectEmojis(subject)
+	if len(emojis) == 0 {
+		return "", nil, nil
+	}
+	return strings.Join(emojis, ""), mapIcons[normalizeEmoji(emojis[0])], subjectTypes(subject)
+}
+
+// groupByType groups the commits in the order of the types in emoji.config,
+// the commits without a known type are grouped last as uncategorized
+func groupByType(commits []*Commit) (groups []*TypeGroup) {
+	m := make(map[*Type]*TypeGroup)
+	for _, c := range commits {
+		typ := c.Type
+		if typ == nil {
+			typ = uncategorized
+		}
+		if m[typ] == nil {
+			m[typ] = &TypeGroup{Type: typ}
+		}
+		m[typ].Commits = append(m[typ].Commits, c)
+	}
+	for _, typ := range append(allTypes, uncategorized) {
+		if group := m[typ]; group != nil {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// lastTag returns the latest release reachable from ref, the highest semver
+// tag like next-version uses, empty if there is none. Other tags like
+// "deploy-x" are not releases.
+func lastTag(ref string) string {
+	tag, _, _ := latestSemverTag(ref)
+	return tag
+}
+
+// revRange returns the range of commits after the tag, or all commits
+func revRange(tag, ref string) string {
+	if tag == "" {
+		return ref
+	}
+	return tag + ".." + ref
+}
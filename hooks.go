@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 )
 
@@ -12,7 +13,8 @@ const (
 	gmojiStartMark = "## START GIT.EMOJI"
 	gmojiEndMark   = "## END GIT.EMOJI"
 
-	_remove           = "remove"
+	hookBackupSuffix = ".git-emoji.bak"
+
 	_commitMsg        = "commit-msg"
 	_prepareCommitMsg = "prepare-commit-msg"
 )
@@ -26,25 +28,27 @@ SHA1=$3
 
 `
 
+// hookContent is the git.emoji block of the hook, in POSIX sh to run in the
+// hooks with a #!/bin/sh shebang too. The tty is probed in a subshell, a
+// failed redirection of exec exits sh.
 func hookContent(hook string) string {
 	return fmt.Sprintf(`%s
 GIT_EMOJI_BIN=%q
-if [[ -x "$GIT_EMOJI_BIN" ]]; then
-  if [[ -c /dev/tty ]]; then
-    if exec 0</dev/tty 2>/dev/null; then
-      "$GIT_EMOJI_BIN" gmoji-%s "$@" < /dev/tty || exit $?
-    else
-      "$GIT_EMOJI_BIN" gmoji-%s "$@" || exit $?
-    fi
+if [ -x "$GIT_EMOJI_BIN" ]; then
+  if [ -c /dev/tty ] && (exec 0</dev/tty) 2>/dev/null; then
+    "$GIT_EMOJI_BIN" gmoji-%s "$@" < /dev/tty || exit $?
   else
     "$GIT_EMOJI_BIN" gmoji-%s "$@" || exit $?
   fi
 fi
-%s`, gmojiStartMark, emojiGit(), hook, hook, hook, gmojiEndMark)
+%s`, gmojiStartMark, emojiGit(), hook, hook, gmojiEndMark)
 }
 
 func setupHooks() bool {
 	debugf("setup hooks")
+	if !isEnabled() {
+		return false
+	}
 	st, err := os.Stat(gitDir())
 	if err != nil {
 		debugf("not a git repository (ignored, todo)")
@@ -60,79 +64,183 @@ func setupHooks() bool {
 	return true
 }
 
-func removeHooks() {
-	setupHook(_commitMsg, _remove)
-	setupHook(_prepareCommitMsg, _remove)
+// removeHooks removes the git.emoji block from the hooks. With restore, the
+// hooks are restored from the backup taken when git.emoji was first installed.
+func removeHooks(restore bool) {
+	removeHook(_commitMsg, initCommitMsg, restore)
+	removeHook(_prepareCommitMsg, initPrepareCommitMsg, restore)
 }
 
-func setupHook(hook, initContent string) {
-	isSetup := initContent != _remove
-	isRemove := initContent == _remove
+// hookPath returns the path of the hook. A symlinked hook resolves to its
+// target, so it is edited and backed up in place instead of being replaced by
+// a regular file.
+func hookPath(hook string) string {
+	path := filepath.Join(gitDir(), "hooks", hook)
+	if target, err := filepath.EvalSymlinks(path); err == nil {
+		return target
+	}
+	return path
+}
 
-	// read file
-	filePath := filepath.Join(gitDir(), "hooks", hook)
+func hookBackupPath(hook string) string {
+	return hookPath(hook) + hookBackupSuffix
+}
+
+func readHook(hook string) (_ string, exists bool) {
+	filePath := hookPath(hook)
 	debugf("hook: %v", filePath)
 	data, err := os.ReadFile(filePath)
 	switch {
 	case err == nil:
-		break
-	case os.IsNotExist(err) && isRemove:
-		return
-	case os.IsNotExist(err) && isSetup:
-		break
+		return string(data), true
+	case os.IsNotExist(err):
+		return "", false
 	default:
 		fatalf("reading %v: %v", hook, err)
+		return "", false
 	}
+}
 
-	// verify and initialize the content
-	dataStr := strings.TrimSpace(string(data))
-	if !strings.HasPrefix(dataStr, `#!`) {
-		dataStr = initContent
-	}
+func setupHook(hook, initContent string) {
+	data, exists := readHook(hook)
 
 	// verify if the hook is already installed
 	hookContentStr := hookContent(hook)
-	if isSetup && strings.Contains(dataStr, hookContentStr) {
+	dataStr, blocks := stripHookBlocks(hook, data)
+	if len(blocks) == 1 && blocks[0] == hookContentStr {
 		debugf("hook %v already installed", hook)
 		return
 	}
 
-	// remove old content
-	dataStr = removeHook(hook, dataStr)
+	// keep the original content, without any git.emoji block
+	dataStr = strings.TrimSpace(dataStr)
+	if exists {
+		backupHook(hook, data, dataStr, initContent)
+	}
+
+	// verify and initialize the content, keeping any existing commands
+	if !strings.HasPrefix(dataStr, `#!`) {
+		dataStr = strings.TrimSpace(initContent + "\n" + dataStr)
+	}
 
 	// append new content
-	if isSetup {
-		dataStr += "\n" + hookContentStr + "\n"
+	dataStr += "\n\n" + hookContentStr + "\n"
+	writeHook(hook, dataStr)
+	debugf("installed %v hook", hook)
+}
+
+func removeHook(hook, initContent string, restore bool) {
+	if restore {
+		backupPath := hookBackupPath(hook)
+		if _, err := os.Stat(backupPath); err == nil {
+			if err = os.Rename(backupPath, hookPath(hook)); err != nil {
+				fatalf("restoring %v: %v", hook, err)
+			}
+			debugf("restored %v hook from %v", hook, backupPath)
+			return
+		}
 	}
 
-	// write back
-	err = os.WriteFile(filePath, []byte(dataStr), 0755)
-	if err != nil {
-		fatalf("writing %v: %v", hook, err)
+	data, exists := readHook(hook)
+	if !exists {
+		return
 	}
-	if isSetup {
-		debugf("installed %v hook", hook)
-	} else {
-		debugf("removed %v hook", hook)
+	dataStr, blocks := stripHookBlocks(hook, data)
+	if len(blocks) == 0 {
+		debugf("hook %v not installed", hook)
+		return
 	}
+
+	// the hook was created by git.emoji, nothing to restore
+	dataStr = strings.TrimSpace(dataStr)
+	if restore && isInitHook(dataStr, initContent) {
+		if err := os.Remove(hookPath(hook)); err != nil {
+			fatalf("removing %v: %v", hook, err)
+		}
+		debugf("removed %v hook file", hook)
+		return
+	}
+	writeHook(hook, dataStr+"\n")
+	debugf("removed %v hook", hook)
 }
 
-func removeHook(hook, dataStr string) string {
-	idx0 := strings.Index(dataStr, gmojiStartMark)
-	idx1 := strings.Index(dataStr, gmojiEndMark)
-	if idx0 >= 0 && idx1 >= 0 {
-		if idx0 > idx1 {
-			fatalf("invalid %v content (idx0 > idx1)", hook)
+// backupHook saves the original hook file as is the first time git.emoji
+// edits it. Hooks that only contain the init content, once stripped of the
+// git.emoji blocks, were created by git.emoji.
+func backupHook(hook, data, strippedStr, initContent string) {
+	backupPath := hookBackupPath(hook)
+	if _, err := os.Stat(backupPath); err == nil {
+		return
+	}
+	if strippedStr == "" || isInitHook(strippedStr, initContent) {
+		return
+	}
+	perm := os.FileMode(0755)
+	if st, err := os.Stat(hookPath(hook)); err == nil {
+		perm = st.Mode().Perm()
+	}
+	if err := writeFileAtomic(backupPath, []byte(data), perm); err != nil {
+		fatalf("backing up %v: %v", hook, err)
+	}
+	debugf("backed up %v hook to %v", hook, backupPath)
+}
+
+func writeHook(hook, dataStr string) {
+	filePath := hookPath(hook)
+	must(0, os.MkdirAll(filepath.Dir(filePath), 0755))
+	perm := os.FileMode(0755)
+	if st, err := os.Stat(filePath); err == nil {
+		perm = st.Mode().Perm() | 0111
+	}
+	if err := writeFileAtomic(filePath, []byte(dataStr), perm); err != nil {
+		fatalf("writing %v: %v", hook, err)
+	}
+}
+
+func isInitHook(dataStr, initContent string) bool {
+	return strings.TrimSpace(dataStr) == strings.TrimSpace(initContent)
+}
+
+// stripHookBlocks removes all git.emoji blocks from the hook content. The
+// hook is left alone when the marks are unbalanced, it is not clear what
+// belongs to git.emoji.
+func stripHookBlocks(hook, dataStr string) (_ string, blocks []string) {
+	var out, block []string
+	inBlock := false
+	for _, line := range strings.Split(dataStr, "\n") {
+		switch strings.TrimSpace(line) {
+		case gmojiStartMark:
+			if inBlock {
+				fatalf("%v: %q without %q, fix the hook by hand", hookPath(hook), gmojiStartMark, gmojiEndMark)
+			}
+			inBlock, block = true, []string{line}
+			continue
+
+		case gmojiEndMark:
+			if !inBlock {
+				fatalf("%v: %q without %q, fix the hook by hand", hookPath(hook), gmojiEndMark, gmojiStartMark)
+			}
+			block = append(block, line)
+			blocks = append(blocks, strings.Join(block, "\n"))
+			inBlock, block = false, nil
+			continue
 		}
-		for idx0 >= 0 && dataStr[idx0-1] == '\n' {
-			idx0--
+		if inBlock {
+			block = append(block, line)
+		} else {
+			out = append(out, line)
 		}
-		dataStr = dataStr[:idx0] + dataStr[idx1+len(gmojiEndMark):]
 	}
-	return strings.TrimSpace(dataStr) + "\n"
+	if inBlock {
+		fatalf("%v: %q without %q, fix the hook by hand", hookPath(hook), gmojiStartMark, gmojiEndMark)
+	}
+	return strings.Join(out, "\n"), blocks
 }
 
 func execCommitMsg(args []string) {
+	if !isEnabled() {
+		return
+	}
 	if len(args) < 1 {
 		fatalf("invalid commit-msg args: %v", args)
 	}
@@ -140,40 +248,114 @@ func execCommitMsg(args []string) {
 	msgFile := commitMsgFile(COMMIT_MSG_FILE)
 	dataStr := string(must(os.ReadFile(msgFile)))
 
+	if popSkipMark() {
+		debugf("commit message skipped by prepare-commit-msg")
+		return
+	}
 	_, ok := validateMsgFile(dataStr)
 	if !ok {
 		fmt.Println("--------------------------------------------------")
 		fmt.Println(strings.Split(dataStr, "\n")[0])
 		fmt.Println("--------------------------------------------------")
+		// without the "fail" policy a missing emoji is only a warning
+		if slices.Contains(settings.NonInteractive, policyFail) {
+			fatalf("commit message must start with an emoji")
+		}
 		errorf("commit message must start with an emoji")
 	}
+	checkTrailers(dataStr)
+	checkMigrationNote(dataStr)
+	if problems := lintMessage(dataStr); len(problems) > 0 {
+		fmt.Println("--------------------------------------------------")
+		fmt.Println(strings.Split(dataStr, "\n")[0])
+		fmt.Println("--------------------------------------------------")
+		fatalf("commit message:\n  - %v", strings.Join(problems, "\n  - "))
+	}
 }
 
 func execPrepareCommitMsg(args []string) {
+	if !isEnabled() {
+		return
+	}
 	if len(args) < 1 {
 		fatalf("invalid prepare-commit-msg args: %v", args)
 	}
-	COMMIT_MSG_FILE := args[0]
+	COMMIT_MSG_FILE, COMMIT_SOURCE := args[0], ""
+	if len(args) > 1 {
+		COMMIT_SOURCE = args[1]
+	}
 	msgFile := commitMsgFile(COMMIT_MSG_FILE)
 	dataStr := string(must(os.ReadFile(msgFile)))
+	popSkipMark() // from a previous aborted commit
 
+	isTty := isTtyAvailable()
 	firstLine, ok := validateMsgFile(dataStr)
 	if ok {
+		if os.Getenv(envReplace) != "" {
+			// -c, -C or --amend with a type flag: the flag wins over the
+			// emoji of the reused message
+			typ, idx, tags := envTypeSpec()
+			emoji := typ.Icons[idx] + strings.Join(tags, "")
+			confirmBreaking(emoji)
+			replaceSubjectEmojis(msgFile, emoji)
+		}
 		debugf("prepare commit message ok, skip")
+		if isTty {
+			askMigrationNote(msgFile)
+			askTrailers(msgFile)
+		}
+		return
+	}
+
+	if !isTty && slices.Contains(settings.NonInteractiveSkip, COMMIT_SOURCE) {
+		debugf("no tty available, skip commit source %q", COMMIT_SOURCE)
+		pushSkipMark()
 		return
 	}
 
-	emoji := "🚧"
-	if isTtyAvailable() {
-		flagType, idx := askFlagType(firstLine)
-		emoji = flagType.Icons[idx]
+	var emoji string
+	var typ *Type
+	switch {
+	case os.Getenv(envType) != "":
+		var idx int
+		var tags []string
+		typ, idx, tags = envTypeSpec()
+		emoji = typ.Icons[idx] + strings.Join(tags, "")
+		confirmBreaking(emoji)
+	case os.Getenv(envInjected) != "" && COMMIT_SOURCE == "message":
+		debugf("emoji %v already injected by git.emoji commit, skip", os.Getenv(envInjected))
+		return
+	case COMMIT_SOURCE == "merge":
+		if typ = lookupType("merge"); typ != nil {
+			emoji = typ.Icons[0]
+		}
+	case strings.HasPrefix(firstLine, `Revert "`):
+		if typ = lookupType("revert"); typ != nil {
+			emoji = typ.Icons[0]
+		}
+	case COMMIT_SOURCE == "squash":
+		emoji = strings.Join(squashedEmojis(dataStr), "")
+	}
+	switch {
+	case emoji != "":
+		debugf("emoji from %v: %v", COMMIT_SOURCE, emoji)
+	case isTty:
+		t, idx, tags := chooseType(firstLine)
+		typ, emoji = t, t.Icons[idx]+strings.Join(tags, "")
+		confirmBreaking(emoji)
 		debugf("emoji: %v", emoji)
-	} else {
-		debugf("no tty available, using fallback emoji: %v", emoji)
+	default:
+		typ = nonInteractiveType()
+		if typ == nil {
+			debugf("no tty available, leave the commit message untouched")
+			return
+		}
+		emoji = typ.Icons[0]
+		debugf("no tty available, using emoji: %v", emoji)
 	}
 
 	var b bytes.Buffer
-	wroteExtra, wroteEmoji := false, false
+	wroteExtra, wroteSubject := false, false
 	writeExtra := func() {
 		if !wroteExtra {
 			b.WriteString("#\n#\n")
@@ -182,14 +364,8 @@ func execPrepareCommitMsg(args []string) {
 		}
 		wroteExtra = true
 	}
-	writeEmoji := func() {
-		if !wroteEmoji {
-			printf(&b, "%s ", emoji)
-		}
-		wroteEmoji = true
-	}
 
-	charN := printf(&b, "%s \n", emoji)
+	charN := printf(&b, "%s\n", formatSubject(emoji, typ, ""))
 	lines := strings.Split(strings.TrimSpace(dataStr), "\n")
 	for _, line := range lines {
 		if line == "" {
@@ -198,15 +374,16 @@ func execPrepareCommitMsg(args []string) {
 		}
 		if strings.HasPrefix(line, "#") {
 			writeExtra()
-		} else {
-			writeEmoji()
+		} else if !wroteSubject {
+			line = formatSubject(emoji, typ, line)
+			wroteSubject = true
 		}
 		b.WriteString(line)
 		b.WriteString("\n")
 	}
 
 	data := b.Bytes()
-	if wroteEmoji {
+	if wroteSubject {
 		data = data[charN:]
 	}
 
@@ -214,6 +391,10 @@ func execPrepareCommitMsg(args []string) {
 	if err != nil {
 		fatalf("preparing commit message: %v", err)
 	}
+	if isTty {
+		askMigrationNote(msgFile)
+		askTrailers(msgFile)
+	}
 	debugf("prepared commit message done")
 }
 
@@ -231,12 +412,82 @@ func validateMsgFile(dataStr string) (firstLine string, ok bool) {
 		break
 	}
 
-	for _, emoji := range allEmojis() {
-		if strings.HasPrefix(firstLine, emoji) {
-			return firstLine, true
+	firstLine = stripAutosquashPrefixes(firstLine)
+	_, ok = subjectEmoji(firstLine)
+	return firstLine, ok
+}
+
+// stripAutosquashPrefixes removes all the prefixes of "fixup! fixup! ✨ x"
+// generated by git commit --fixup, --squash, so it is classified as "✨ x"
+func stripAutosquashPrefixes(line string) string {
+	for {
+		rest, ok := cutAutosquashPrefix(line)
+		if !ok {
+			return line
 		}
+		line = rest
 	}
-	return firstLine, false
+}
+
+func cutAutosquashPrefix(line string) (string, bool) {
+	for _, prefix := range []string{"fixup! ", "squash! ", "amend! "} {
+		if strings.HasPrefix(line, prefix) {
+			return strings.TrimPrefix(line, prefix), true
+		}
+	}
+	return line, false
+}
+
+// replaceSubjectEmojis replaces the emojis of the subject of the message file
+func replaceSubjectEmojis(msgFile, emoji string) {
+	lines := strings.Split(string(must(os.ReadFile(msgFile))), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue // before the subject
+		}
+		lines[i] = replaceEmojis(line, emoji)
+		break
+	}
+	must(0, os.WriteFile(msgFile, []byte(strings.Join(lines, "\n")), 0644))
+}
+
+// squashedEmojis collects the distinct emojis of the commits listed in the
+// message generated by "git merge --squash":
+//
+//	Squashed commit of the following:
+//
+//	commit 1234abcd...
+//	Author: ...
+//
+//	    ✨ subject
+func squashedEmojis(dataStr string) (out []string) {
+	inCommit := false
+	for _, line := range strings.Split(dataStr, "\n") {
+		switch {
+		case strings.HasPrefix(line, "commit "):
+			inCommit = true
+		case inCommit && strings.HasPrefix(line, "    "):
+			inCommit = false
+			for _, emoji := range subjectEmojis(strings.TrimSpace(line)) {
+				if !slices.Contains(out, emoji) {
+					out = append(out, emoji)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// the skip mark tells commit-msg that prepare-commit-msg intentionally left
+// the message untouched
+func skipMarkPath() string { return filepath.Join(gitDir(), "emoji.skip") }
+
+func pushSkipMark() {
+	must(0, os.WriteFile(skipMarkPath(), nil, 0644))
+}
+
+func popSkipMark() bool {
+	return os.Remove(skipMarkPath()) == nil
 }
 
 func commitMsgFile(commitMsgFile string) string {
@@ -253,6 +504,9 @@ func commitMsgFile(commitMsgFile string) string {
 }
 
 func isTtyAvailable() bool {
+	if parseGitBool(os.Getenv(envNonInteractive)) {
+		return false
+	}
 	if _, err := os.Stat("/dev/tty"); err != nil {
 		return false
 	}
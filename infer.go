@@ -0,0 +1,139 @@
+package main
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// nonInteractiveType chooses the type when no tty is available, following the
+// noninteractive policies. It returns nil to leave the message untouched.
+func nonInteractiveType() *Type {
+	for _, policy := range settings.NonInteractive {
+		var typ *Type
+		switch policy {
+		case policyBranch:
+			typ = typeFromBranch(currentBranch())
+		case policyPaths:
+			typ = typeFromPaths(stagedPaths())
+		case policyDefault:
+			typ = lookupType(settings.DefaultType)
+		case policyFail:
+			return nil
+		}
+		if typ != nil {
+			debugf("noninteractive policy %q: %v", policy, typ.Name)
+			return typ
+		}
+	}
+	return nil
+}
+
+func currentBranch() string {
+	branch, _, err := execGitx("symbolic-ref", "--short", "-q", "HEAD")
+	if err != nil {
+		return ""
+	}
+	return branch
+}
+
+func stagedPaths() []string {
+	out, _, err := execGitx("diff", "--cached", "--name-only")
+	if err != nil || out == "" {
+		return nil
+	}
+	return strings.Split(out, "\n")
+}
+
+// chooseType asks for the type and the secondary tags, or uses the type
+// inferred from the branch name according to branch-mode.
+func chooseType(firstLine string) (*Type, int, []string) {
+	if settings.BranchMode == branchModeOff {
+		return askFlagType(firstLine, nil)
+	}
+	branchType := typeFromBranch(currentBranch())
+	if branchType != nil && settings.BranchMode == branchModeAuto {
+		debugf("type from branch: %v", branchType.Name)
+		return branchType, 0, nil
+	}
+	return askFlagType(firstLine, branchType)
+}
+
+// typeFromBranch infers the type from the branch patterns of the types, or
+// from the first segment of the branch name, which can be an alias or a name
+// of the type: feat/login, fix/JIRA-123
+func typeFromBranch(branch string) *Type {
+	if branch == "" {
+		return nil
+	}
+	for _, typ := range allTypes {
+		for _, pattern := range typ.Branches {
+			if matchBranch(pattern, branch) {
+				return typ
+			}
+		}
+	}
+	prefix, _, ok := strings.Cut(branch, "/")
+	if !ok {
+		return nil
+	}
+	return lookupType(strings.ToLower(prefix))
+}
+
+// typeFromPaths returns the first type whose paths match all staged paths
+func typeFromPaths(paths []string) *Type {
+	if len(paths) == 0 {
+		return nil
+	}
+	for _, typ := range allTypes {
+		if len(typ.Paths) == 0 {
+			continue
+		}
+		matchAll := true
+		for _, p := range paths {
+			if !matchAnyPath(typ.Paths, p) {
+				matchAll = false
+				break
+			}
+		}
+		if matchAll {
+			return typ
+		}
+	}
+	return nil
+}
+
+// matchBranch matches a branch name against a pattern, where "*" matches any
+// characters including "/", so "feat/*" matches "feat/JIRA-123/login".
+func matchBranch(pattern, branch string) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	ok, _ := regexp.MatchString("^"+expr+"$", branch)
+	return ok
+}
+
+// matchAnyPath matches a slash-separated path against the patterns:
+//
+//	dir/       any path inside a directory named dir
+//	*_test.go  a pattern without slash matches the base name
+//	docs/*.md  a pattern with slash matches the whole path
+func matchAnyPath(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		switch {
+		case strings.HasSuffix(pattern, "/"):
+			if strings.HasPrefix(name, pattern) || strings.Contains(name, "/"+pattern) {
+				return true
+			}
+		case !strings.Contains(pattern, "/"):
+			if ok, _ := path.Match(pattern, path.Base(name)); ok {
+				return true
+			}
+		default:
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
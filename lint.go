@@ -0,0 +1,276 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// scissors line of "git commit --verbose", everything below is ignored
+const scissorsLine = "# ------------------------ >8 ------------------------"
+
+// lintMessage checks the commit message against the lint-* settings and the
+// require-body of its type, and returns the problems
+func lintMessage(msg string) (problems []string) {
+	subject, blank, body := splitMessage(msg)
+	if subject == "" {
+		return nil
+	}
+	if _, ok := cutAutosquashPrefix(subject); ok {
+		return nil // linted on the commit it is squashed into
+	}
+	addf := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	text := subjectText(subject)
+	if limit := settings.LintMaxSubject; limit > 0 {
+		if width := displayWidth(subject); width > limit {
+			addf("subject is %d columns, longer than %d", width, limit)
+		}
+	}
+	if settings.LintNoPeriod && strings.HasSuffix(text, ".") && !strings.HasSuffix(text, "...") {
+		addf("subject must not end with a period")
+	}
+	if settings.LintImperative {
+		if word, ok := nonImperative(text); ok {
+			addf("subject must use the imperative mood (%q instead of %q)", imperativeOf(word), word)
+		}
+	}
+	if settings.LintBlankLine && len(body) > 0 && !blank {
+		addf("subject and body must be separated by a blank line")
+	}
+
+	for _, line := range bodyLines(msg) {
+		// a long word like an URL can not be wrapped
+		if limit := settings.LintBodyWrap; limit > 0 && strings.Contains(strings.TrimSpace(line.text), " ") {
+			if width := displayWidth(line.text); width > limit {
+				addf("line %d is %d columns, longer than %d", line.num, width, limit)
+			}
+		}
+	}
+
+	for _, word := range settings.LintBannedWords {
+		re := regexp.MustCompile(`(?i)(^|\W)` + regexp.QuoteMeta(word) + `($|\W)`)
+		if re.MatchString(subject) || re.MatchString(strings.Join(body, "\n")) {
+			addf("message must not contain %q", word)
+		}
+	}
+
+	for _, typ := range subjectTypes(subject) {
+		if typ.RequireBody && !hasBody(msg) {
+			addf("%v commits require a body explaining the change", typ.Name)
+		}
+	}
+	return problems
+}
+
+// splitMessage returns the subject and the body lines, without the comments.
+// blank reports whether a blank line follows the subject.
+func splitMessage(msg string) (subject string, blank bool, body []string) {
+	var lines []string
+	for _, line := range strings.Split(msg, "\n") {
+		if line == scissorsLine {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, strings.TrimRight(line, " \t\r"))
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return "", false, nil
+	}
+	subject, body = lines[0], lines[1:]
+	if len(body) > 0 && body[0] == "" {
+		blank = true
+		body = body[1:]
+	}
+	return subject, blank, body
+}
+
+// messageLine is a line of the message with its number in the editor
+type messageLine struct {
+	num  int
+	text string
+}
+
+// bodyLines returns the lines after the subject without the comments and the
+// trailer block, numbered like in the editor
+func bodyLines(msg string) (lines []messageLine) {
+	subject := false
+	for i, line := range strings.Split(msg, "\n") {
+		if line == scissorsLine {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !subject {
+			subject = strings.TrimSpace(line) != ""
+			continue
+		}
+		lines = append(lines, messageLine{i + 1, strings.TrimRight(line, " \t\r")})
+	}
+	for len(lines) > 0 && lines[len(lines)-1].text == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	// the trailers are the last paragraph
+	if trailers := parseTrailers(msg); len(trailers) > 0 {
+		start := len(lines)
+		for start > 0 && lines[start-1].text != "" {
+			start--
+		}
+		if isTrailerBlock(lines[start:], trailers) {
+			lines = lines[:start]
+		}
+	}
+	return lines
+}
+
+// isTrailerBlock reports whether the paragraph has all the trailers
+func isTrailerBlock(paragraph []messageLine, trailers []string) bool {
+	for _, trailer := range trailers {
+		key, _, _ := strings.Cut(trailer, ":")
+		found := slices.ContainsFunc(paragraph, func(line messageLine) bool {
+			k, _, ok := strings.Cut(line.text, ":")
+			return ok && strings.EqualFold(strings.TrimSpace(k), strings.TrimSpace(key))
+		})
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hasBody reports whether the message has a body besides the trailers
+func hasBody(msg string) bool {
+	for _, line := range bodyLines(msg) {
+		if strings.TrimSpace(line.text) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+var reSubjectPrefix = regexp.MustCompile(`^(?:\[[^\]]*\]\s*|\([^)]*\)\s*|\S+:(?:\s+|$))`)
+
+// subjectText returns the subject without the emojis and the leading ticket,
+// scope or type prefixes: "💻 [auth] PROJ-1: add login" → "add login"
+func subjectText(subject string) string {
+	if settings.EmojiPosition == positionEnd {
+		for {
+			emoji, ok := trailingEmoji(subject)
+			if !ok {
+				break
+			}
+			subject = strings.TrimSpace(strings.TrimSuffix(subject, emoji))
+		}
+	}
+	_, text := cutEmojiPrefix(subject)
+	for {
+		text = strings.TrimSpace(text)
+		if emoji, ok := leadingEmoji(text); ok {
+			text = text[len(emoji):]
+			continue
+		}
+		if loc := reSubjectPrefix.FindStringIndex(text); loc != nil {
+			text = text[loc[1]:]
+			continue
+		}
+		return text
+	}
+}
+
+// common verbs of commit subjects, to detect "adds" and "fixes"
+var imperativeVerbs = map[string]bool{}
+
+func init() {
+	for _, verb := range strings.Fields(`add allow apply avoid bump build call change
+		check clean cleanup configure convert copy create delete deprecate disable
+		document drop enable ensure expose extract fix format generate handle hide
+		implement improve include initialize install introduce keep load log make
+		merge migrate move optimize parse prevent print refactor release remove
+		rename reorganize replace restore return revert rewrite run set show skip
+		sort split start stop support switch test tidy update upgrade use validate
+		wrap write`) {
+		imperativeVerbs[verb] = true
+	}
+}
+
+// nonImperative reports the first word of the subject when it looks like a
+// past tense, a gerund or a third person verb: "added", "adding", "adds"
+func nonImperative(text string) (string, bool) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", false
+	}
+	word := strings.ToLower(strings.TrimRightFunc(fields[0], unicode.IsPunct))
+	if imperativeVerbs[word] {
+		return "", false
+	}
+	return word, imperativeOf(word) != word
+}
+
+// imperativeOf returns the known verb of "added", "adding", "adds", or the
+// word itself
+func imperativeOf(word string) string {
+	for _, suffix := range []string{"ed", "d", "ing", "es", "s"} {
+		stem, ok := strings.CutSuffix(word, suffix)
+		if !ok {
+			continue
+		}
+		for _, verb := range []string{stem, stem + "e", stem[:max(len(stem)-1, 0)]} {
+			if imperativeVerbs[verb] {
+				return verb
+			}
+		}
+		if suffix == "ed" && strings.HasSuffix(stem, "i") && imperativeVerbs[stem[:len(stem)-1]+"y"] {
+			return stem[:len(stem)-1] + "y" // applied → apply
+		}
+	}
+	return word
+}
+
+// displayWidth returns the number of terminal columns of the string: emojis
+// and East Asian wide characters take 2 columns, combining marks none
+func displayWidth(s string) (width int) {
+	for s != "" {
+		if emoji, ok := nextEmoji(s); ok {
+			width += 2
+			s = s[len(emoji):]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case isWideRune(r):
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+func isWideRune(r rune) bool {
+	return r >= 0x1100 && (r <= 0x115F || // Hangul Jamo
+		r >= 0x2E80 && r <= 0xA4CF && r != 0x303F || // CJK ... Yi
+		r >= 0xAC00 && r <= 0xD7A3 || // Hangul Syllables
+		r >= 0xF900 && r <= 0xFAFF || // CJK Compatibility Ideographs
+		r >= 0xFE30 && r <= 0xFE4F || // CJK Compatibility Forms
+		r >= 0xFF00 && r <= 0xFF60 || // Fullwidth Forms
+		r >= 0xFFE0 && r <= 0xFFE6 ||
+		r >= 0x20000 && r <= 0x3FFFD)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strconv"
@@ -14,6 +15,24 @@ import (
 
 var allTypes []*Type
 var mapTypes map[string]*Type
+var mapIcons map[string]*Type // normalized icon to type
+var settings Settings
+
+const (
+	// set by execCommit when the emoji is already injected into the message
+	envInjected = "GIT_EMOJI_INJECTED"
+	// the type to use instead of asking: feat, ft1, "fix test" for secondary
+	// tags; also set by execCommit to pass the type flags to prepare-commit-msg
+	envType = "GIT_EMOJI_TYPE"
+	// GIT_EMOJI_SKIP=1 runs git as if git.emoji was disabled, without
+	// skipping the other hooks like --no-verify does
+	envSkip = "GIT_EMOJI_SKIP"
+	// GIT_EMOJI_NONINTERACTIVE=1 never asks, as if there was no tty
+	envNonInteractive = "GIT_EMOJI_NONINTERACTIVE"
+	// set by execCommit with a type flag, to replace the emoji of a reused
+	// message (-c, -C, --amend) in prepare-commit-msg
+	envReplace = "GIT_EMOJI_REPLACE"
+)
 
 func main() {
 	arg := ""
@@ -25,6 +44,12 @@ func main() {
 	case "", "emoji", "help", "-h", "--help":
 		printHelp()
 		os.Exit(0)
+	case "search":
+		execSearch(os.Args[2:])
+		os.Exit(0)
+	case "disable", "enable":
+		execPolicy(arg, os.Args[2:])
+		os.Exit(0)
 	}
 
 	// if not in git repo, run git command directly
@@ -39,6 +64,41 @@ func main() {
 		loadConfig()
 		writeConfigFile(allTypes)
 
+	case "changelog":
+		debugf("git.emoji %q", os.Args[1:])
+		loadConfig()
+		execChangelog(os.Args[2:])
+
+	case "blame":
+		debugf("git.emoji %q", os.Args[1:])
+		loadConfig()
+		execBlame(os.Args[2:])
+
+	case "shortlog":
+		debugf("git.emoji %q", os.Args[1:])
+		loadConfig()
+		execShortlog(os.Args[2:])
+
+	case "squash-message":
+		debugf("git.emoji %q", os.Args[1:])
+		loadConfig()
+		execSquashMessage(os.Args[2:])
+
+	case "retype":
+		debugf("git.emoji %q", os.Args[1:])
+		loadConfig()
+		execRetype(os.Args[2:])
+
+	case "next-version":
+		debugf("git.emoji %q", os.Args[1:])
+		loadConfig()
+		execNextVersion(os.Args[2:])
+
+	case "release":
+		debugf("git.emoji %q", os.Args[1:])
+		loadConfig()
+		execRelease(os.Args[2:])
+
 	case "rev-parse":
 		// no setup hooks
 		execGit(os.Args[1:])
@@ -55,19 +115,27 @@ func main() {
 
 	case "setup-hooks":
 		debugf("git.emoji %q", os.Args[1:])
+		if enabled, reason := resolvePolicy(); !enabled {
+			fatalf("git.emoji is disabled (%v), run \"git.emoji enable\" first", reason)
+		}
 		setupHooks()
 		infof("✅ Successfully setup git hooks")
 
 	case "remove-hooks":
 		debugf("git.emoji %q", os.Args[1:])
-		removeHooks()
-		infof("✅ Successfully removed git hooks")
+		restore := slices.Contains(os.Args[2:], "--restore")
+		removeHooks(restore)
+		if restore {
+			infof("✅ Successfully restored git hooks")
+		} else {
+			infof("✅ Successfully removed git hooks")
+		}
 
-	case "commit":
-		if !isOptOut() {
+	case "commit", "merge", "tag", "revert", "cherry-pick", "stash":
+		if isEnabled() {
 			setupHooks()
 			loadConfig()
-			execCommit(os.Args[1:])
+			execCommand(os.Args[1:], messageCommands[arg])
 			return
 		}
 		fallthrough
@@ -108,75 +176,191 @@ func execGit(args []string) {
 }
 
 func execGitx(args ...string) (string, string, error) {
+	stdout, stderr, err := execGitRaw(args...)
+	return strings.TrimSpace(stdout), stderr, err
+}
+
+// execGitRaw is execGitx without trimming the output, for the outputs where
+// the whitespace matters like "git blame --porcelain"
+func execGitRaw(args ...string) (string, string, error) {
 	debugf("%v %q", origGit(), args)
 
 	stdout, stderr := &strings.Builder{}, &strings.Builder{}
 	cmd := exec.Command(origGit(), args...)
 	cmd.Stdout, cmd.Stderr = stdout, stderr
 
+	err := cmd.Run()
+	return stdout.String(), stderr.String(), err
+}
+
+// execGitInput runs a git command with the input on stdin
+func execGitInput(input string, args ...string) (string, string, error) {
+	debugf("%v %q", origGit(), args)
+	stdout, stderr := &strings.Builder{}, &strings.Builder{}
+	cmd := exec.Command(origGit(), args...)
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+
 	err := cmd.Run()
 	return strings.TrimSpace(stdout.String()), stderr.String(), err
 }
 
 func execCommit(args []string) {
-	getFlagType := func() (_ *Type, remain []string) {
-		for i, arg := range args {
-			if !strings.HasPrefix(arg, "-") {
-				continue
-			}
-			arg = strings.TrimPrefix(arg, "-")
-			arg = strings.TrimPrefix(arg, "-")
-			if t, ok := mapTypes[arg]; ok {
-				var _args []string
-				_args = append(_args, args[:i]...)
-				_args = append(_args, args[i+1:]...)
-				return t, _args
-			}
+	execCommand(args, messageCommands["commit"])
+}
+
+// execCommand adds the emoji to the message of a git command (commit, merge,
+// tag, ...) from the type flag, the default type of the command, or the
+// prompt.
+func execCommand(args []string, cmd messageCommand) {
+	if cmd.pair {
+		args = pairTrailers(args)
+	}
+	args, parsed, flagType, idx, tags := parseGitArgs(args, cmd.options)
+	fromFlag := flagType != nil
+	if flagType == nil && os.Getenv(envType) != "" {
+		flagType, idx, tags = envTypeSpec()
+	}
+	if flagType == nil && cmd.defaultType != "" {
+		flagType = lookupType(cmd.defaultType)
+	}
+
+	var message, file *gitArg
+	for i := range parsed {
+		arg := &parsed[i]
+		switch {
+		case arg.is("fixup"), arg.is("squash"):
+			// the message is generated by git: "fixup! <subject>"
+			debugf("--%v: keep the generated message", arg.opt.long)
+			execGit(args)
+			return
+		case arg.is("message") && message == nil:
+			message = arg // only the first paragraph gets the emoji
+		case arg.is("file") && file == nil:
+			file = arg
+		case arg.opt == nil && cmd.messageAfter != "" && arg.value == cmd.messageAfter && i+1 < len(parsed):
+			message = &parsed[i+1] // git stash save <message>
 		}
-		return nil, args
 	}
-	editMsgArg := func(emoji string) (_ []string, ok bool) {
-		for i, arg := range args {
-			if arg != "-m" && strings.HasPrefix(arg, "-m") {
-				msg := strings.TrimPrefix(arg, "-m")
-				args[i] = "-m" + emoji + " " + msg
-				ok = true
-				continue
+	// the prompt reads the answer from stdin, already read by -F -
+	fromStdin := file != nil && file.value == "-"
+	chooseFlagType := func(firstLine string) bool {
+		switch {
+		case flagType != nil:
+			return true
+		case fromStdin:
+			flagType, idx, tags = nonInteractiveType(), 0, nil
+			return flagType != nil
+		case cmd.ask && isTtyAvailable():
+			flagType, idx, tags = chooseType(firstLine)
+			return true
+		default:
+			return false
+		}
+	}
+
+	switch {
+	case message != nil:
+		firstLine, ok := validateMsgFile(message.value)
+		if ok || !chooseFlagType(firstLine) {
+			break
+		}
+		emoji := flagType.Icons[idx] + strings.Join(tags, "")
+		confirmBreaking(emoji)
+		message.set(args, formatMessage(emoji, flagType, message.value))
+		must(0, os.Setenv(envInjected, emoji))
+
+	case file != nil:
+		dataStr := readMsgFile(file.value)
+		msgFile := filepath.Join(gitDir(), "EMOJI_EDITMSG")
+		firstLine, ok := validateMsgFile(dataStr)
+		if ok || !chooseFlagType(firstLine) {
+			if fromStdin {
+				// git can not read stdin again
+				must(0, os.WriteFile(msgFile, []byte(dataStr), 0644))
+				file.set(args, msgFile)
 			}
-			if arg != "-m" {
-				continue
+			break
+		}
+		emoji := flagType.Icons[idx] + strings.Join(tags, "")
+		if !fromStdin {
+			confirmBreaking(emoji)
+		}
+		must(0, os.WriteFile(msgFile, []byte(formatMsgFile(emoji, flagType, dataStr)), 0644))
+		file.set(args, msgFile)
+		must(0, os.Setenv(envInjected, emoji))
+
+	case flagType != nil && cmd.hooks:
+		// -C, -c or the editor: let prepare-commit-msg apply the type
+		primary := flagType.Icons[idx]
+		if len(flagType.Alias) > 0 {
+			primary = flagType.Alias[0] + strconv.Itoa(idx)
+		}
+		must(0, os.Setenv(envType, strings.Join(append([]string{primary}, tags...), " ")))
+		if fromFlag {
+			must(0, os.Setenv(envReplace, "1"))
+		}
+
+	case fromFlag:
+		// without hook, the message of the editor can not get the emoji
+		hint := "-m"
+		if slices.ContainsFunc(cmd.options, func(opt *gitOption) bool { return opt.long == "file" }) {
+			hint = "-m or -F"
+		}
+		fatalf("type flags on git %v need a message with %v", args[0], hint)
+	}
+	execGit(args)
+}
+
+// envTypeSpec returns the types from GIT_EMOJI_TYPE, a list of aliases with an
+// optional icon index (feat, ft1), type names (Features) or emojis. The first
+// one is the primary type, the emojis of the others are secondary tags.
+func envTypeSpec() (typ *Type, idx int, tags []string) {
+	value := os.Getenv(envType)
+	for _, spec := range splitList(value) {
+		t, i, ok := parseTypeSpec(spec)
+		if !ok {
+			if emoji, ok := shortcodeToEmoji(spec); ok {
+				spec = emoji
 			}
-			if len(args) > i+1 {
-				args[i+1] = emoji + " " + args[i+1]
-				ok = true
+			switch {
+			case lookupType(spec) != nil:
+				t, i = lookupType(spec), 0
+			case isEmoji(spec):
+				t, i = typeOfIcon(spec)
+			default:
+				fatalf("invalid %v=%q: unknown type %q", envType, value, spec)
 			}
 		}
-		return args, ok
+		if typ == nil {
+			typ, idx = t, i
+		} else {
+			tags = append(tags, t.Icons[i])
+		}
 	}
-
-	// skip prompt if there is no -m arg
-	if !slices.Contains(args, "-m") {
-		execGit(args)
-		return
+	if typ == nil {
+		fatalf("invalid %v=%q: no type", envType, value)
 	}
+	return typ, idx, tags
+}
 
-	// detect -feat, -ch, etc. or show prompt if missing
-	idx := 0
-	flagType, args := getFlagType()
-	if flagType == nil {
-		flagType, idx = askFlagType("")
+// readMsgFile reads the message of "git commit -F <file>", "-" is stdin
+func readMsgFile(file string) string {
+	if file == "-" {
+		return string(must(io.ReadAll(os.Stdin)))
 	}
-	if flagType == nil {
-		fatalf("Can not read emoji!")
-		return
+	data, err := os.ReadFile(file)
+	if err != nil {
+		fatalf("reading message file: %v", err)
 	}
-
-	// update -m 'message' with emoji
-	args, _ = editMsgArg(flagType.Icons[idx])
-	execGit(args)
+	return string(data)
 }
 
-func askFlagType(firstLine string) (_ *Type, idx int) {
+// askFlagType shows the prompt to choose the type. When preselect is not nil,
+// an empty input chooses it. Several choices can be entered, like "fx ts" or
+// "🐛🧪": the first one is the primary type, the emojis of the others are
+// returned as secondary tags.
+func askFlagType(firstLine string, preselect *Type) (_ *Type, idx int, tags []string) {
 	reNum := regexp.MustCompile(`^\d+`)
 	reTxt := regexp.MustCompile(`^[a-z]+`)
 	parse := func(re *regexp.Regexp, s string) (string, string, bool) {
@@ -202,51 +386,91 @@ func askFlagType(firstLine string) (_ *Type, idx int) {
 		fmt.Printf("%s\n\n", firstLine)
 	}
 
-	input, prompt := "", "Enter a number or abbr or emoji (1 | 1a | ft | ft1): "
-	mapEmoji := mapEmojis()
-	for {
-		fmt.Printf("\r%s\r", strings.Repeat(" ", len(prompt)+len(input)+1))
-		fmt.Print(prompt)
-
-		input = readLine()
-		in := strings.Trim(input, "- \t\n")
-
+	// parseChoice parses one choice: 1, 1a, ft, ft1, :bug:, 🐛
+	parseChoice := func(in string) (*Type, int, bool) {
 		if first, second, ok := parse(reNum, in); ok {
 			id := must(strconv.Atoi(first))
 			id--
 			if id < 0 || id >= len(allTypes) {
-				continue
+				return nil, 0, false
 			}
 			typ := allTypes[id]
 			if second == "" {
-				return typ, 0
+				return typ, 0, true
 			}
-			idx = int(second[0]-'a') + 1
-			if idx < 0 || idx >= len(typ.Icons) {
-				continue
+			idx := int(second[0]-'a') + 1
+			if len(second) > 1 || idx < 0 || idx >= len(typ.Icons) {
+				return nil, 0, false
 			}
-			return typ, idx
+			return typ, idx, true
 		}
 		if first, second, ok := parse(reTxt, in); ok {
 			typ := mapTypes[first]
 			if typ == nil {
-				continue
+				return nil, 0, false
 			}
 			if second == "" {
-				return typ, 0
+				return typ, 0, true
+			}
+			idx, err := strconv.Atoi(second)
+			if err != nil || idx < 0 || idx >= len(typ.Icons) {
+				return nil, 0, false
+			}
+			return typ, idx, true
+		}
+		if emoji, ok := shortcodeToEmoji(in); ok {
+			in = emoji
+		}
+		if isEmoji(in) {
+			typ, idx := typeOfIcon(in)
+			return typ, idx, true
+		}
+		return nil, 0, false
+	}
+
+	input, prompt := "", "Enter numbers or abbrs or emojis (1 | 1a | ft | ft1 | :bug: | fx ts): "
+	if preselect != nil {
+		prompt = fmt.Sprintf("Enter numbers or abbrs or emojis (1 | 1a | ft | ft1 | :bug: | fx ts) [%s %s]: ", preselect.Icons[0], preselect.Name)
+	}
+next:
+	for {
+		fmt.Printf("\r%s\r", strings.Repeat(" ", len(prompt)+len(input)+1))
+		fmt.Print(prompt)
+
+		input = readLine()
+		in := strings.Trim(input, "- \t\n")
+		if in == "" && preselect != nil {
+			return preselect, 0, nil
+		}
+
+		var choices []string
+		for _, word := range splitList(in) {
+			// adjacent emojis: 🐛🧪
+			for word != "" {
+				emoji, ok := nextEmoji(word)
+				if !ok {
+					choices = append(choices, strings.TrimLeft(word, "-"))
+					break
+				}
+				choices = append(choices, emoji)
+				word = word[len(emoji):]
 			}
-			var err error
-			idx, err = strconv.Atoi(second)
-			if err != nil {
-				continue
+		}
+		var typ *Type
+		tags = nil
+		for _, choice := range choices {
+			t, i, ok := parseChoice(choice)
+			if !ok {
+				continue next
 			}
-			if idx < 0 || idx >= len(typ.Icons) {
-				continue
+			if typ == nil {
+				typ, idx = t, i
+			} else if emoji := t.Icons[i]; emoji != typ.Icons[idx] && !slices.Contains(tags, emoji) {
+				tags = append(tags, emoji)
 			}
-			return typ, idx
 		}
-		if _, ok := mapEmoji[in]; ok {
-			return &Type{Icons: []string{in}}, 0
+		if typ != nil {
+			return typ, idx, tags
 		}
 	}
 }
@@ -258,6 +482,9 @@ git.emoji is a tool to commit your changes with emoji. It provides a simple comm
 
 SETUP:
   git.emoji setup-hooks
+  git.emoji remove-hooks [--restore]    # --restore: restore the hooks from backup
+  git.emoji disable [--local | --global | --path <glob>]
+  git.emoji enable  [--local | --global | --path <glob>]
 
 USAGE:
   git.emoji commit -feat -m 'message'   # Features
@@ -265,6 +492,7 @@ USAGE:
   git.emoji commit -ft1  -m 'message'   # Features
   git.emoji commit -ch   -m 'message'   # Chore
   git.emoji commit -ch1  -m 'message'   # Chore
+  git.emoji commit -ft --pair alice -m 'message'   # Co-authored-by from [git.emoji.team]
 
 OPTIONAL: add this to your .zshrc or .bashrc:
 
@@ -277,6 +505,45 @@ then use git.emoji as git alias:
   git commit -ch   -m 'message'   # Chore
   git commit -ch1  -m 'message'   # Chore
 
+  git merge -feat -m 'message' <branch>
+  git tag -a -rel -m 'message' <tag>
+  git stash push -ch -m 'message'
+
+RETYPE: change the emoji of a commit, keeping the rest of the message:
+
+  git.emoji retype -fix            # amend HEAD
+  git.emoji retype HEAD~3 -fix     # amend an older commit with rebase --autosquash
+
+CHANGELOG: print the changelog of the tags, with a built-in or a text/template file:
+
+  git.emoji changelog [--since <tag>] [--format md|html|json] [--template <file>]
+
+  # insert the releases newer than the latest version of the file at its top
+  git.emoji changelog --update CHANGELOG.md
+
+SHORTLOG: summarize the commits by author like git shortlog, with the number of each type:
+
+  git.emoji shortlog [-s] [-n] [-e] [<revision range>] [[--] <path>...]
+
+BLAME: annotate each line of a file with the type of its commit, and the share of each type:
+
+  git.emoji blame [--summary] [-L <range>] [<rev>] [--] <file>
+
+SQUASH: print the message of a squash merge, with the emoji of the dominant type:
+
+  git.emoji squash-message <base> [<head>] [--subject <text>] [--priority]
+
+RELEASE: create an annotated tag with the changes since the previous tag:
+
+  git.emoji release [<version>] [--version-file <file>] [--dry-run]
+
+  # print the next semantic version from the commit types since the latest tag
+  git.emoji next-version [--from <tag>] [--verbose]
+
+SEARCH: find emojis by name:
+
+  git.emoji search <words>...
+
 CONFIG: run this command to customize your emoji:
 
   git.emoji write-config
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// The policy decides whether git.emoji is enabled in a repository. It is
+// stored in the git config:
+//
+//	emoji.enabled       false to disable, in the local or global config
+//	emoji.disabledPath  glob of directories where it is disabled (global)
+//	emoji.enabledPath   glob of directories where it is enabled (global)
+//
+// The local config wins over the paths, which win over the global config.
+// When several paths match, the last one wins. A .git/emoji.not file or
+// GIT_EMOJI_SKIP=1 also disables it.
+const (
+	cfgEnabled      = "emoji.enabled"
+	cfgDisabledPath = "emoji.disabledPath"
+	cfgEnabledPath  = "emoji.enabledPath"
+)
+
+var _isEnabled *bool
+
+// isEnabled reports whether git.emoji is enabled in the current repository
+func isEnabled() bool {
+	if _isEnabled == nil {
+		enabled, reason := resolvePolicy()
+		debugf("git.emoji enabled=%v (%v)", enabled, reason)
+		_isEnabled = &enabled
+	}
+	return *_isEnabled
+}
+
+func resolvePolicy() (enabled bool, reason string) {
+	if parseGitBool(os.Getenv(envSkip)) {
+		return false, envSkip
+	}
+	if _, err := os.Stat(optOutPath()); err == nil {
+		return false, ".git/emoji.not exists"
+	}
+
+	// exit code 1 when there is no entry
+	out, errStr, err := execGitx("config", "--show-scope", "--get-regexp", `^emoji\.(enabled|disabledpath|enabledpath)$`)
+	if err != nil && errStr != "" {
+		debugf("reading policy: %v\n%s", err, errStr)
+		return true, "default"
+	}
+
+	var local, global, path string
+	var pathEnabled bool
+	for _, line := range strings.Split(out, "\n") {
+		scope, entry, _ := strings.Cut(line, "\t")
+		key, value, hasValue := strings.Cut(entry, " ")
+		switch key {
+		case strings.ToLower(cfgEnabled):
+			if !hasValue {
+				value = "true" // "[emoji] enabled" without value
+			}
+			if scope == "local" || scope == "worktree" {
+				local = value
+			} else {
+				global = value
+			}
+		case strings.ToLower(cfgDisabledPath), strings.ToLower(cfgEnabledPath):
+			if matchRepoDir(value, rootRepoDir()) {
+				path, pathEnabled = value, key == strings.ToLower(cfgEnabledPath)
+			}
+		}
+	}
+	switch {
+	case local != "":
+		return parseGitBool(local), "local " + cfgEnabled
+	case path != "":
+		return pathEnabled, "path " + path
+	case global != "":
+		return parseGitBool(global), "global " + cfgEnabled
+	}
+	return true, "default"
+}
+
+func optOutPath() string {
+	return filepath.Join(gitDir(), "emoji.not")
+}
+
+func parseGitBool(value string) bool {
+	switch strings.ToLower(value) {
+	case "false", "no", "off", "0", "":
+		return false
+	}
+	return true
+}
+
+// matchRepoDir matches the directory or one of its parents against the glob.
+// A leading "~/" is the home directory.
+func matchRepoDir(pattern, dir string) bool {
+	if rest, ok := strings.CutPrefix(pattern, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return false
+		}
+		pattern = filepath.Join(home, rest)
+	}
+	pattern = filepath.Clean(pattern)
+	for {
+		if ok, _ := filepath.Match(pattern, dir); ok {
+			return true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return false
+		}
+		dir = parent
+	}
+}
+
+// execPolicy enables or disables git.emoji:
+//
+//	git.emoji disable|enable [--local | --global | --path <glob>]
+//
+// The default is --local, for the current repository.
+func execPolicy(cmd string, args []string) {
+	opts, positional := parseCmdArgs(args, "--path")
+	checkCmdOptions(cmd, opts, "--local", "--global", "--path")
+	if len(positional) > 0 || len(opts) > 1 {
+		fatalf("usage: git.emoji %v [--local | --global | --path <glob>]", cmd)
+	}
+	enable := cmd == "enable"
+	value := map[bool]string{true: "true", false: "false"}[enable]
+	switch {
+	case opts["--global"] != "":
+		gitConfig("--global", cfgEnabled, value)
+		infof("✅ Successfully %vd git.emoji globally", cmd)
+
+	case opts["--path"] != "":
+		// relative to the current directory, "~/" is kept to follow the home
+		glob := opts["--path"]
+		if !strings.HasPrefix(glob, "~/") {
+			glob = must(filepath.Abs(glob))
+		}
+		key, otherKey := cfgDisabledPath, cfgEnabledPath
+		if enable {
+			key, otherKey = otherKey, key
+		}
+		// re-add the glob at the end, so it wins over the previous paths
+		unsetGitConfig("--global", otherKey, glob)
+		unsetGitConfig("--global", key, glob)
+		gitConfig("--global", "--add", key, glob)
+		infof("✅ Successfully %vd git.emoji in %v", cmd, glob)
+
+	default:
+		if !_tryInit() {
+			fatalf(msgNotGitRepo)
+		}
+		if enable {
+			if err := os.Remove(optOutPath()); err == nil {
+				infof("removed .git/emoji.not")
+			}
+		}
+		gitConfig("--local", cfgEnabled, value)
+		infof("✅ Successfully %vd git.emoji in this repository", cmd)
+	}
+	if !enable {
+		infof("run \"git.emoji remove-hooks\" to also remove the installed hooks")
+	}
+}
+
+func gitConfig(args ...string) {
+	args = append([]string{"config"}, args...)
+	if _, errStr, err := execGitx(args...); err != nil {
+		fatalf("git %v: %v\n%s", strings.Join(args, " "), err, errStr)
+	}
+}
+
+// unsetGitConfig removes the entries of the key with the exact value
+func unsetGitConfig(scope, key, value string) {
+	// exit code 5 when there is nothing to unset
+	_, _, _ = execGitx("config", scope, "--unset-all", key, "^"+regexp.QuoteMeta(value)+"$")
+}
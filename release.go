@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var reVersion = regexp.MustCompile(`v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?`)
+
+// execRelease creates an annotated tag with the Releases emoji and a summary
+// of the commits since the previous tag, grouped by type.
+//
+//	git.emoji release [<version>] [--version-file <file>] [--dry-run]
+//
+// Without version, the next version is computed like next-version.
+func execRelease(args []string) {
+	opts, positional := parseCmdArgs(args, "--version-file")
+	checkCmdOptions("release", opts, "--version-file", "--dry-run")
+	if len(positional) > 1 {
+		fatalf("usage: git.emoji release [<version>] [--version-file <file>] [--dry-run]")
+	}
+	var version string
+	if len(positional) == 1 {
+		version = positional[0]
+	} else {
+		next, level, tag := nextVersion("")
+		if level == bumpNone && tag == "" {
+			fatalf("nothing to release, no commit bumps the version")
+		} else if level == bumpNone {
+			fatalf("nothing to release, no commit since %v bumps the version", tag)
+		}
+		version = next.String()
+	}
+	// fail before the version file is committed
+	if _, _, err := execGitx("rev-parse", "--verify", "--quiet", "refs/tags/"+version); err == nil {
+		fatalf("tag %v already exists", version)
+	}
+
+	prevTag := lastTag("HEAD")
+	commits := gitLog("--no-merges", revRange(prevTag, "HEAD"))
+	msg := releaseMessage(version, prevTag, commits)
+	if opts["--dry-run"] != "" {
+		fmt.Print(msg)
+		return
+	}
+
+	if file := opts["--version-file"]; file != "" {
+		bumpVersionFile(file, version)
+		if _, errStr, err := execGitx("add", "--", file); err != nil {
+			fatalf("adding %v: %v\n%s", file, err, errStr)
+		}
+		subject := releaseIcon() + " Release " + version
+		if _, errStr, err := execGitx("commit", "-m", subject, "--", file); err != nil {
+			fatalf("committing %v: %v\n%s", file, err, errStr)
+		}
+		infof("✅ Updated %v to %v", file, version)
+	}
+
+	msgFile := filepath.Join(gitDir(), "EMOJI_TAGMSG")
+	must(0, os.WriteFile(msgFile, []byte(msg), 0644))
+	if _, errStr, err := execGitx("tag", "-a", version, "-F", msgFile, "--cleanup=verbatim"); err != nil {
+		fatalf("creating tag %v: %v\n%s", version, err, errStr)
+	}
+	infof("✅ Created tag %v", version)
+}
+
+// releaseIcon returns the emoji of the release commit and tag, as a shortcode
+// with shortcodes = true like the other subjects
+func releaseIcon() string {
+	if typ := lookupType("release"); typ != nil {
+		return outputEmoji(typ.Icons[0])
+	}
+	return outputEmoji("🚀")
+}
+
+// releaseMessage formats the tag message:
+//
+//	🚀 Release v1.2.0
+//
+//	Changes since v1.1.0:
+//
+//	Features:
+//	- 💻 add login page (1234abc)
+func releaseMessage(version, prevTag string, commits []*Commit) string {
+	var b strings.Builder
+	printf(&b, "%s Release %s\n", releaseIcon(), version)
+	if prevTag != "" {
+		printf(&b, "\nChanges since %s:\n", prevTag)
+	}
+	for _, group := range groupByType(commits) {
+		printf(&b, "\n%s:\n", group.Type.Name)
+		for _, c := range group.Commits {
+			printf(&b, "- %s (%s)\n", c.Subject, c.ShortHash())
+		}
+	}
+	return b.String()
+}
+
+// bumpVersionFile replaces the first version in the file, or writes the
+// version to a new file. The "v" prefix follows the existing version. A file
+// without version is not overwritten.
+func bumpVersionFile(file, version string) {
+	data, err := os.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		fatalf("reading %v: %v", file, err)
+	}
+	bare := strings.TrimPrefix(version, "v")
+	loc := reVersion.FindIndex(data)
+	if os.IsNotExist(err) {
+		data = []byte(bare + "\n")
+	} else if loc == nil {
+		fatalf("no version in %v to update", file)
+	} else {
+		newVersion := bare
+		if data[loc[0]] == 'v' {
+			newVersion = "v" + bare
+		}
+		data = append(data[:loc[0]:loc[0]], append([]byte(newVersion), data[loc[1]:]...)...)
+	}
+	if err = os.WriteFile(file, data, 0644); err != nil {
+		fatalf("writing %v: %v", file, err)
+	}
+}
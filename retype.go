@@ -0,0 +1,92 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// execRetype changes the emojis of a commit to another type, keeping the rest
+// of the message:
+//
+//	git.emoji retype [<commit>] -fix [-test]
+//
+// HEAD is amended. An older commit gets an "amend!" commit that is squashed
+// into it by "git rebase --autosquash". Without type flag, the type is asked.
+func execRetype(args []string) {
+	positional, _, flagType, idx, tags := parseGitArgs(args, nil)
+	if len(positional) > 1 || len(positional) == 1 && strings.HasPrefix(positional[0], "-") {
+		fatalf("usage: git.emoji retype [<commit>] -<type>")
+	}
+	rev := "HEAD"
+	if len(positional) == 1 {
+		rev = positional[0]
+	}
+	sha := revParse(rev + "^{commit}")
+	head := revParse("HEAD")
+	msg, errStr, err := execGitx("log", "-1", "--format=%B", sha)
+	if err != nil {
+		fatalf("reading commit %v: %v\n%s", rev, err, errStr)
+	}
+	subject, body, _ := strings.Cut(msg, "\n")
+
+	if flagType == nil {
+		if !isTtyAvailable() {
+			fatalf("usage: git.emoji retype [<commit>] -<type>")
+		}
+		flagType, idx, tags = chooseType(subject)
+	}
+	emoji := flagType.Icons[idx] + strings.Join(tags, "")
+	confirmBreaking(emoji)
+	newSubject := replaceEmojis(subject, emoji)
+	if newSubject == subject {
+		infof("👉 %v is already %v", shortSha(sha), emoji)
+		return
+	}
+	newMsg := newSubject + "\n" + body + "\n"
+
+	msgFile := filepath.Join(gitDir(), "EMOJI_RETYPEMSG")
+	if sha == head {
+		must(0, os.WriteFile(msgFile, []byte(newMsg), 0644))
+		execGit([]string{"commit", "--amend", "--only", "--quiet", "-F", msgFile})
+		infof("✅ Retyped %v: %v", shortSha(sha), newSubject)
+		return
+	}
+
+	if _, _, err := execGitx("merge-base", "--is-ancestor", sha, "HEAD"); err != nil {
+		fatalf("%v is not an ancestor of HEAD", rev)
+	}
+	if merges, _, _ := execGitx("rev-list", "--merges", sha+"..HEAD"); merges != "" {
+		fatalf("can not retype %v: there are merge commits after it", rev)
+	}
+
+	// amend! <subject>
+	//
+	// <new message>
+	must(0, os.WriteFile(msgFile, []byte("amend! "+subject+"\n\n"+newMsg), 0644))
+	amend, errStr, err := execGitx("commit-tree", "HEAD^{tree}", "-p", "HEAD", "-F", msgFile)
+	if err != nil {
+		fatalf("creating the amend! commit: %v\n%s", err, errStr)
+	}
+	if _, errStr, err = execGitx("update-ref", "-m", "git.emoji retype", "HEAD", amend, head); err != nil {
+		fatalf("creating the amend! commit: %v\n%s", err, errStr)
+	}
+
+	base := sha + "^"
+	if _, _, err := execGitx("rev-parse", "--verify", "--quiet", base); err != nil {
+		base = "--root"
+	}
+	// the other commits are replayed as they are
+	must(0, os.Setenv(envSkip, "1"))
+	must(0, os.Setenv("GIT_SEQUENCE_EDITOR", "true"))
+	execGit([]string{"rebase", "--quiet", "--interactive", "--autosquash", "--autostash", base})
+	infof("✅ Retyped %v: %v", shortSha(sha), newSubject)
+}
+
+func revParse(rev string) string {
+	sha, errStr, err := execGitx("rev-parse", "--verify", rev)
+	if err != nil {
+		fatalf("unknown revision %v: %v\n%s", rev, err, errStr)
+	}
+	return sha
+}
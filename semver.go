@@ -0,0 +1,194 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type bumpLevel int
+
+const (
+	bumpNone bumpLevel = iota
+	bumpPatch
+	bumpMinor
+	bumpMajor
+)
+
+var bumpLevelNames = []string{"none", "patch", "minor", "major"}
+
+func (b bumpLevel) String() string { return bumpLevelNames[b] }
+
+func parseBumpLevel(s string) (bumpLevel, error) {
+	for i, name := range bumpLevelNames {
+		if s == name {
+			return bumpLevel(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown bump level %q (expected one of %v)", s, strings.Join(bumpLevelNames, ", "))
+}
+
+var reSemver = regexp.MustCompile(`^(v?)(\d+)\.(\d+)\.(\d+)(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+type semver struct {
+	prefix              string // "v" or empty
+	major, minor, patch int
+	pre                 string
+}
+
+func parseSemver(s string) (v semver, ok bool) {
+	m := reSemver.FindStringSubmatch(s)
+	if m == nil {
+		return v, false
+	}
+	v.prefix = m[1]
+	v.major, _ = strconv.Atoi(m[2])
+	v.minor, _ = strconv.Atoi(m[3])
+	v.patch, _ = strconv.Atoi(m[4])
+	v.pre = m[5]
+	return v, true
+}
+
+func (v semver) String() string {
+	s := fmt.Sprintf("%s%d.%d.%d", v.prefix, v.major, v.minor, v.patch)
+	if v.pre != "" {
+		s += "-" + v.pre
+	}
+	return s
+}
+
+// bump returns the next version. A pre-release is released as is when the
+// level would not go past it: 1.2.0-rc.1 + minor = 1.2.0
+func (v semver) bump(level bumpLevel) semver {
+	pre := v.pre
+	v.pre = ""
+	switch level {
+	case bumpMajor:
+		if pre == "" || v.minor != 0 || v.patch != 0 {
+			v.major, v.minor, v.patch = v.major+1, 0, 0
+		}
+	case bumpMinor:
+		if pre == "" || v.patch != 0 {
+			v.minor, v.patch = v.minor+1, 0
+		}
+	case bumpPatch:
+		if pre == "" {
+			v.patch++
+		}
+	case bumpNone:
+		v.pre = pre
+	}
+	return v
+}
+
+// compare orders the versions by semver precedence, the prefix aside. A
+// pre-release is lower than its release: 1.2.0-rc.1 < 1.2.0
+func (v semver) compare(w semver) int {
+	if c := cmp.Or(cmp.Compare(v.major, w.major), cmp.Compare(v.minor, w.minor), cmp.Compare(v.patch, w.patch)); c != 0 {
+		return c
+	}
+	switch {
+	case v.pre == w.pre:
+		return 0
+	case v.pre == "":
+		return 1
+	case w.pre == "":
+		return -1
+	}
+	// dot separated identifiers, numbers are lower than words
+	vIDs, wIDs := strings.Split(v.pre, "."), strings.Split(w.pre, ".")
+	for i := 0; i < len(vIDs) && i < len(wIDs); i++ {
+		vNum, vErr := strconv.Atoi(vIDs[i])
+		wNum, wErr := strconv.Atoi(wIDs[i])
+		var c int
+		switch {
+		case vErr == nil && wErr == nil:
+			c = cmp.Compare(vNum, wNum)
+		case vErr == nil:
+			c = -1
+		case wErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(vIDs[i], wIDs[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(vIDs), len(wIDs))
+}
+
+// latestSemverTag returns the highest semver tag reachable from ref, by semver
+// precedence and not by name: v1.2.0 is above v1.2.0-rc.1 and 1.10.0 is above
+// v1.9.0
+func latestSemverTag(ref string) (string, semver, bool) {
+	out, _, err := execGitx("tag", "--merged", ref)
+	if err != nil || out == "" {
+		return "", semver{}, false
+	}
+	return highestSemver(strings.Split(out, "\n"))
+}
+
+// highestSemver returns the highest of the semver tags, the others are skipped
+func highestSemver(tags []string) (latest string, latestVersion semver, found bool) {
+	for _, tag := range tags {
+		v, ok := parseSemver(tag)
+		if !ok || found && v.compare(latestVersion) <= 0 {
+			continue
+		}
+		latest, latestVersion, found = tag, v, true
+	}
+	return latest, latestVersion, found
+}
+
+// bumpOf returns the highest bump level of the types of the commits,
+// including the secondary tags. Commits without a known type are patches.
+func bumpOf(commits []*Commit) bumpLevel {
+	level := bumpNone
+	for _, c := range commits {
+		if len(c.Types) == 0 {
+			level = max(level, bumpPatch)
+		}
+		for _, typ := range c.Types {
+			level = max(level, typ.Bump)
+		}
+	}
+	return level
+}
+
+// execNextVersion prints the next version from the commits since the latest
+// semver tag.
+//
+//	git.emoji next-version [--from <tag>] [--verbose]
+func execNextVersion(args []string) {
+	opts, positional := parseCmdArgs(args, "--from")
+	checkCmdOptions("next-version", opts, "--from", "--verbose")
+	if len(positional) != 0 {
+		fatalf("usage: git.emoji next-version [--from <tag>] [--verbose]")
+	}
+	next, level, tag := nextVersion(opts["--from"])
+	if opts["--verbose"] != "" {
+		if tag == "" {
+			tag = "(no tag)"
+		}
+		infof("%v → %v (%v)", tag, next, level)
+	}
+	fmt.Println(next)
+}
+
+func nextVersion(from string) (next semver, level bumpLevel, tag string) {
+	var current semver
+	if from != "" {
+		var ok bool
+		if current, ok = parseSemver(from); !ok {
+			fatalf("not a semver tag: %v", from)
+		}
+		tag = from
+	} else if tag, current, _ = latestSemverTag("HEAD"); tag == "" {
+		current.prefix = "v"
+	}
+	level = bumpOf(gitLog("--no-merges", revRange(tag, "HEAD")))
+	return current.bump(level), level, tag
+}
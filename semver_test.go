@@ -0,0 +1,74 @@
+package main
+
+import "testing"
+
+func TestParseSemver(t *testing.T) {
+	tests := []struct {
+		in   string
+		want semver
+		ok   bool
+	}{
+		{"v1.2.3", semver{prefix: "v", major: 1, minor: 2, patch: 3}, true},
+		{"1.2.3", semver{major: 1, minor: 2, patch: 3}, true},
+		{"v1.2.0-rc.1", semver{prefix: "v", major: 1, minor: 2, pre: "rc.1"}, true},
+		{"1.2.0-rc.1+build.5", semver{major: 1, minor: 2, pre: "rc.1"}, true},
+		{"v1.2", semver{}, false},
+		{"deploy-1.2.3", semver{}, false},
+		{"release", semver{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseSemver(tt.in)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseSemver(%q) = %+v, %v, want %+v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBump(t *testing.T) {
+	tests := []struct {
+		from  string
+		level bumpLevel
+		want  string
+	}{
+		{"v1.2.3", bumpNone, "v1.2.3"},
+		{"v1.2.3", bumpPatch, "v1.2.4"},
+		{"v1.2.3", bumpMinor, "v1.3.0"},
+		{"v1.2.3", bumpMajor, "v2.0.0"},
+		{"1.2.3", bumpPatch, "1.2.4"},
+		{"v1.2.0-rc.1", bumpNone, "v1.2.0-rc.1"},
+		{"v1.2.0-rc.1", bumpPatch, "v1.2.0"},
+		{"v1.2.0-rc.1", bumpMinor, "v1.2.0"},
+		{"v1.2.0-rc.1", bumpMajor, "v2.0.0"},
+		{"v2.0.0-rc.1", bumpMajor, "v2.0.0"},
+		{"v1.2.1-rc.1", bumpMinor, "v1.3.0"},
+	}
+	for _, tt := range tests {
+		v, _ := parseSemver(tt.from)
+		if got := v.bump(tt.level).String(); got != tt.want {
+			t.Errorf("%v + %v = %v, want %v", tt.from, tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestHighestSemver(t *testing.T) {
+	tests := []struct {
+		tags []string
+		want string
+	}{
+		{[]string{"v1.2.0-rc.1", "v1.2.0"}, "v1.2.0"},
+		{[]string{"v1.2.0", "v1.2.0-rc.1"}, "v1.2.0"},
+		{[]string{"v1.9.0", "v1.10.0"}, "v1.10.0"},
+		{[]string{"v1.2.0", "1.3.0"}, "1.3.0"},
+		{[]string{"2.0.0", "v1.5.0"}, "2.0.0"},
+		{[]string{"v1.2.0-rc.2", "v1.2.0-rc.10", "v1.1.0"}, "v1.2.0-rc.10"},
+		{[]string{"v1.2.0-alpha", "v1.2.0-alpha.1", "v1.2.0-1"}, "v1.2.0-alpha.1"},
+		{[]string{"v1.2.0-beta", "v1.2.0-alpha.5"}, "v1.2.0-beta"},
+		{[]string{"deploy-3", "v0.1.0", "latest"}, "v0.1.0"},
+		{[]string{"deploy-3", ""}, ""},
+	}
+	for _, tt := range tests {
+		if got, _, _ := highestSemver(tt.tags); got != tt.want {
+			t.Errorf("highestSemver(%q) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// GitHub-style shortcodes, a hand-picked subset of GitHub's set: all gitmoji,
+// the icons of the default emoji.config and common ones. Other shortcodes are
+// not recognized. When an emoji has several shortcodes, the first one is used
+// for output.
+var _shortcodes = [][2]string{
+	// gitmoji
+	{"art", "🎨"},
+	{"zap", "⚡"},
+	{"fire", "🔥"},
+	{"bug", "🐛"},
+	{"ambulance", "🚑"},
+	{"sparkles", "✨"},
+	{"memo", "📝"},
+	{"rocket", "🚀"},
+	{"lipstick", "💄"},
+	{"tada", "🎉"},
+	{"white_check_mark", "✅"},
+	{"lock", "🔒"},
+	{"closed_lock_with_key", "🔐"},
+	{"bookmark", "🔖"},
+	{"rotating_light", "🚨"},
+	{"construction", "🚧"},
+	{"green_heart", "💚"},
+	{"arrow_down", "⬇️"},
+	{"arrow_up", "⬆️"},
+	{"pushpin", "📌"},
+	{"construction_worker", "👷"},
+	{"chart_with_upwards_trend", "📈"},
+	{"recycle", "♻️"},
+	{"heavy_plus_sign", "➕"},
+	{"heavy_minus_sign", "➖"},
+	{"wrench", "🔧"},
+	{"hammer", "🔨"},
+	{"globe_with_meridians", "🌐"},
+	{"pencil2", "✏️"},
+	{"poop", "💩"},
+	{"rewind", "⏪"},
+	{"twisted_rightwards_arrows", "🔀"},
+	{"package", "📦"},
+	{"alien", "👽"},
+	{"truck", "🚚"},
+	{"page_facing_up", "📄"},
+	{"boom", "💥"},
+	{"bento", "🍱"},
+	{"wheelchair", "♿"},
+	{"bulb", "💡"},
+	{"beers", "🍻"},
+	{"speech_balloon", "💬"},
+	{"card_file_box", "🗃️"},
+	{"loud_sound", "🔊"},
+	{"mute", "🔇"},
+	{"busts_in_silhouette", "👥"},
+	{"children_crossing", "🚸"},
+	{"building_construction", "🏗️"},
+	{"iphone", "📱"},
+	{"clown_face", "🤡"},
+	{"egg", "🥚"},
+	{"see_no_evil", "🙈"},
+	{"camera_flash", "📸"},
+	{"alembic", "⚗️"},
+	{"mag", "🔍"},
+	{"label", "🏷️"},
+	{"seedling", "🌱"},
+	{"triangular_flag_on_post", "🚩"},
+	{"goal_net", "🥅"},
+	{"dizzy", "💫"},
+	{"wastebasket", "🗑️"},
+	{"passport_control", "🛂"},
+	{"adhesive_bandage", "🩹"},
+	{"monocle_face", "🧐"},
+	{"coffin", "⚰️"},
+	{"test_tube", "🧪"},
+	{"necktie", "👔"},
+	{"stethoscope", "🩺"},
+	{"bricks", "🧱"},
+	{"technologist", "🧑‍💻"},
+	{"money_with_wings", "💸"},
+	{"thread", "🧵"},
+	{"safety_vest", "🦺"},
+
+	// default emoji.config
+	{"computer", "💻"},
+	{"hammer_and_wrench", "🛠️"},
+	{"whale", "🐳"},
+	{"soap", "🧼"},
+	{"broom", "🧹"},
+	{"hourglass_flowing_sand", "⏳"},
+
+	// common
+	{"+1", "👍"},
+	{"thumbsup", "👍"},
+	{"-1", "👎"},
+	{"thumbsdown", "👎"},
+	{"heart", "❤️"},
+	{"star", "⭐"},
+	{"star2", "🌟"},
+	{"warning", "⚠️"},
+	{"x", "❌"},
+	{"heavy_check_mark", "✔️"},
+	{"question", "❓"},
+	{"exclamation", "❗"},
+	{"heavy_exclamation_mark", "❗"},
+	{"hourglass", "⌛"},
+	{"gear", "⚙️"},
+	{"books", "📚"},
+	{"book", "📖"},
+	{"pencil", "📝"},
+	{"link", "🔗"},
+	{"key", "🔑"},
+	{"unlock", "🔓"},
+	{"bell", "🔔"},
+	{"no_bell", "🔕"},
+	{"gem", "💎"},
+	{"robot", "🤖"},
+	{"penguin", "🐧"},
+	{"apple", "🍎"},
+	{"checkered_flag", "🏁"},
+	{"scissors", "✂️"},
+	{"paperclip", "📎"},
+	{"calendar", "📆"},
+	{"clipboard", "📋"},
+	{"file_folder", "📁"},
+	{"inbox_tray", "📥"},
+	{"outbox_tray", "📤"},
+	{"email", "📧"},
+	{"chart_with_downwards_trend", "📉"},
+	{"bar_chart", "📊"},
+	{"stopwatch", "⏱️"},
+	{"alarm_clock", "⏰"},
+	{"nut_and_bolt", "🔩"},
+	{"shield", "🛡️"},
+	{"100", "💯"},
+	{"ok_hand", "👌"},
+	{"pray", "🙏"},
+	{"clap", "👏"},
+	{"wave", "👋"},
+	{"eyes", "👀"},
+	{"thinking", "🤔"},
+	{"smile", "😄"},
+	{"joy", "😂"},
+	{"wink", "😉"},
+	{"sweat_smile", "😅"},
+	{"skull", "💀"},
+	{"ghost", "👻"},
+	{"bomb", "💣"},
+	{"collision", "💥"},
+	{"zzz", "💤"},
+	{"dash", "💨"},
+	{"snowflake", "❄️"},
+	{"rainbow", "🌈"},
+	{"mag_right", "🔎"},
+	{"fast_forward", "⏩"},
+	{"arrow_right", "➡️"},
+	{"no_entry", "⛔"},
+	{"no_entry_sign", "🚫"},
+	{"hankey", "💩"},
+	{"shit", "💩"},
+}
+
+var _mapShortcodes map[string]string      // shortcode to emoji
+var _mapShortcodeEmojis map[string]string // normalized emoji to shortcode
+
+func _initShortcodes() {
+	if _mapShortcodes != nil {
+		return
+	}
+	_mapShortcodes = make(map[string]string)
+	_mapShortcodeEmojis = make(map[string]string)
+	for _, pair := range _shortcodes {
+		code, emoji := ":"+pair[0]+":", pair[1]
+		_mapShortcodes[code] = emoji
+		key := strings.ReplaceAll(emoji, "\uFE0F", "")
+		if _, ok := _mapShortcodeEmojis[key]; !ok {
+			_mapShortcodeEmojis[key] = code
+		}
+	}
+}
+
+var reShortcode = regexp.MustCompile(`^:[a-z0-9_+-]+:`)
+var reTrailingShortcode = regexp.MustCompile(`:[a-z0-9_+-]+:$`)
+
+// shortcodeToEmoji converts ":bug:" to "🐛"
+func shortcodeToEmoji(code string) (string, bool) {
+	_initShortcodes()
+	emoji, ok := _mapShortcodes[code]
+	return emoji, ok
+}
+
+// emojiToShortcode converts "🐛" to ":bug:"
+func emojiToShortcode(emoji string) (string, bool) {
+	_initShortcodes()
+	code, ok := _mapShortcodeEmojis[strings.ReplaceAll(emoji, "\uFE0F", "")]
+	return code, ok
+}
+
+// leadingShortcode returns the known shortcode at the start of the line
+func leadingShortcode(line string) (string, bool) {
+	code := reShortcode.FindString(line)
+	if _, ok := shortcodeToEmoji(code); !ok {
+		return "", false
+	}
+	return code, true
+}
+
+// trailingShortcode returns the known shortcode at the end of the line
+func trailingShortcode(line string) (string, bool) {
+	code := reTrailingShortcode.FindString(line)
+	if _, ok := shortcodeToEmoji(code); !ok {
+		return "", false
+	}
+	return code, true
+}
+
+// outputEmoji converts the emojis to shortcodes when the "shortcodes" setting
+// is on. Emojis without shortcode are kept.
+func outputEmoji(emojis string) string {
+	if !settings.Shortcodes {
+		return emojis
+	}
+	var b strings.Builder
+	for emojis != "" {
+		emoji, ok := leadingEmoji(emojis)
+		if !ok {
+			b.WriteString(emojis)
+			break
+		}
+		if code, ok := emojiToShortcode(emoji); ok {
+			b.WriteString(code)
+		} else {
+			b.WriteString(emoji)
+		}
+		emojis = emojis[len(emoji):]
+	}
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Author is the commits of an author, by the name and email of the mailmap
+type Author struct {
+	Name    string
+	Email   string
+	Commits []*Commit // newest first
+}
+
+// execShortlog prints the commits by author like "git shortlog", with the
+// number of commits of each type:
+//
+//	git.emoji shortlog [-s] [-n] [-e] [<revision range>] [[--] <path>...]
+//
+//	Alice (5): 3 💻, 2 🚧
+//	      💻 add the login page
+//	      ...
+func execShortlog(args []string) {
+	var paths []string
+	if i := slices.Index(args, "--"); i >= 0 {
+		args, paths = args[:i], args[i+1:]
+	}
+	opts, positional := parseCmdArgs(expandShortFlags(args, "sne"))
+	checkCmdOptions("shortlog", opts, "-s", "--summary", "-n", "--numbered", "-e", "--email")
+	summary := opts["-s"] != "" || opts["--summary"] != ""
+	numbered := opts["-n"] != "" || opts["--numbered"] != ""
+	email := opts["-e"] != "" || opts["--email"] != ""
+
+	if len(positional) == 0 {
+		positional = []string{"HEAD"}
+	}
+	logArgs := append(positional, "--")
+	commits := gitLog(append(logArgs, paths...)...)
+	for _, author := range groupByAuthor(commits, email, numbered) {
+		name := author.Name
+		if email {
+			name += " <" + author.Email + ">"
+		}
+		if summary {
+			fmt.Printf("%6d\t%s: %s\n", len(author.Commits), name, typeCounts(author.Commits))
+			continue
+		}
+		fmt.Printf("%s (%d): %s\n", name, len(author.Commits), typeCounts(author.Commits))
+		// oldest first, like git shortlog
+		for i := len(author.Commits) - 1; i >= 0; i-- {
+			fmt.Printf("      %s\n", author.Commits[i].Subject)
+		}
+		fmt.Println()
+	}
+}
+
+// expandShortFlags splits the combined short flags: "-sne" → "-s -n -e"
+func expandShortFlags(args []string, flags string) (out []string) {
+	for _, arg := range args {
+		name, ok := strings.CutPrefix(arg, "-")
+		if !ok || len(name) < 2 || strings.HasPrefix(name, "-") || strings.Trim(name, flags) != "" {
+			out = append(out, arg)
+			continue
+		}
+		for _, flag := range name {
+			out = append(out, "-"+string(flag))
+		}
+	}
+	return out
+}
+
+// groupByAuthor groups the commits by author name, or name and email, sorted
+// by name or by number of commits
+func groupByAuthor(commits []*Commit, byEmail, numbered bool) (authors []*Author) {
+	m := make(map[string]*Author)
+	for _, c := range commits {
+		key := c.Author
+		if byEmail {
+			key += " <" + c.Email + ">"
+		}
+		if m[key] == nil {
+			m[key] = &Author{Name: c.Author, Email: c.Email}
+			authors = append(authors, m[key])
+		}
+		m[key].Commits = append(m[key].Commits, c)
+	}
+	slices.SortStableFunc(authors, func(a, b *Author) int {
+		if numbered && len(a.Commits) != len(b.Commits) {
+			return len(b.Commits) - len(a.Commits)
+		}
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Email, b.Email))
+	})
+	return authors
+}
+
+// typeCounts formats the number of commits of each type, in the order of
+// emoji.config: "3 💻, 2 🚧, 1 Uncategorized"
+func typeCounts(commits []*Commit) string {
+	var counts []string
+	for _, group := range groupByType(commits) {
+		label := group.Type.Name
+		if len(group.Type.Icons) > 0 {
+			label = group.Type.Icons[0]
+		}
+		counts = append(counts, fmt.Sprintf("%d %s", len(group.Commits), label))
+	}
+	return strings.Join(counts, ", ")
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// execSquashMessage prints the message of a squash merge of the commits in
+// <base>..<head>:
+//
+//	git.emoji squash-message <base> [<head>] [--subject <text>] [--priority]
+//
+// The subject gets the emoji of the dominant type, the type of most commits,
+// or with --priority the type that comes first in emoji.config. Ties are
+// broken by the order in emoji.config. The body lists the commits grouped by
+// type.
+func execSquashMessage(args []string) {
+	opts, positional := parseCmdArgs(args, "--subject")
+	checkCmdOptions("squash-message", opts, "--subject", "--priority")
+	if len(positional) < 1 || len(positional) > 2 {
+		fatalf("usage: git.emoji squash-message <base> [<head>] [--subject <text>] [--priority]")
+	}
+	head := "HEAD"
+	if len(positional) == 2 {
+		head = positional[1]
+	}
+	var commits []*Commit
+	for _, c := range gitLog("--no-merges", positional[0]+".."+head) {
+		if _, ok := cutAutosquashPrefix(c.Subject); !ok {
+			commits = append(commits, c)
+		}
+	}
+	if len(commits) == 0 {
+		fatalf("no commits in %v..%v", positional[0], head)
+	}
+	fmt.Print(squashMessage(commits, opts["--subject"], opts["--priority"] != ""))
+}
+
+// squashMessage formats the squash message:
+//
+//	🚧 fix the login redirect
+//
+//	Bug Fixes:
+//	- 🚧 fix the login redirect (1234abc)
+//	- 🐛 handle expired tokens (5678def)
+//
+//	Tests:
+//	- 🧪 cover the redirect (9abcdef)
+func squashMessage(commits []*Commit, subject string, priority bool) string {
+	groups := groupByType(commits)
+	var top *TypeGroup
+	for _, group := range groups {
+		if group.Type == uncategorized {
+			continue
+		}
+		if top == nil || !priority && len(group.Commits) > len(top.Commits) {
+			top = group
+		}
+	}
+
+	typ := lookupType(settings.DefaultType)
+	var emoji string
+	if top != nil {
+		typ = top.Type
+	}
+	if typ != nil {
+		emoji = typ.Icons[0]
+	}
+	if subject == "" {
+		// the oldest commit of the type describes the work best
+		oldest := commits[len(commits)-1]
+		if top != nil {
+			oldest = top.Commits[len(top.Commits)-1]
+		}
+		subject = oldest.subjectWithoutEmoji()
+	}
+
+	var b strings.Builder
+	if emoji != "" {
+		subject = formatSubject(emoji, typ, subject)
+	}
+	printf(&b, "%s\n", subject)
+	for _, group := range groups {
+		printf(&b, "\n%s:\n", group.Type.Name)
+		for _, c := range group.Commits {
+			printf(&b, "- %s (%s)\n", c.Subject, c.ShortHash())
+		}
+	}
+	return b.String()
+}
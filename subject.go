@@ -0,0 +1,254 @@
+package main
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var reEmptyBrackets = regexp.MustCompile(`\(\)|\[\]|\{\}`)
+var reTemplateToken = regexp.MustCompile(`\{[a-z]+\}`)
+var reScopePrefix = regexp.MustCompile(`^\[[^\]]*\]\s*`)
+var reDefaultTicket = regexp.MustCompile(`[A-Z][A-Z0-9]+-[0-9]+`)
+var _reTicketPrefix *regexp.Regexp
+
+// formatMessage formats the first line of a message with formatSubject and
+// keeps the rest of the message.
+func formatMessage(emoji string, typ *Type, msg string) string {
+	subject, rest, ok := strings.Cut(msg, "\n")
+	subject = formatSubject(emoji, typ, subject)
+	if ok {
+		return subject + "\n" + rest
+	}
+	return subject
+}
+
+// formatSubject renders the subject template with the tokens:
+//
+//	{emoji}    the chosen emoji
+//	{type}     the first alias of the type (feat, fix, ...)
+//	{scope}    extracted from the branch name with scope-pattern
+//	{ticket}   extracted from the branch name with ticket-pattern
+//	{subject}  the original subject
+//
+// Tokens without value are removed together with their punctuation, so
+// "{ticket}: {subject}" renders as "subject" when there is no ticket.
+//
+// {emoji} is only used with emoji-position = start. With other positions, the
+// emoji is inserted into the rendered subject by insertEmoji.
+func formatSubject(emoji string, typ *Type, subject string) string {
+	emoji = outputEmoji(emoji)
+	if settings.EmojiPosition != positionStart {
+		return insertEmoji(renderSubject("", typ, subject), emoji)
+	}
+	return renderSubject(emoji, typ, subject)
+}
+
+func renderSubject(emoji string, typ *Type, subject string) string {
+	branch := currentBranch()
+	ticket := extractFromBranch(settings.TicketPattern, branch)
+	if ticket != "" && strings.Contains(subject, ticket) {
+		ticket = "" // already in the subject
+	}
+	typeName := ""
+	if typ != nil && len(typ.Alias) > 0 {
+		typeName = typ.Alias[0]
+	}
+	replacer := strings.NewReplacer(
+		"{emoji}", emoji,
+		"{type}", typeName,
+		"{scope}", extractFromBranch(settings.ScopePattern, branch),
+		"{ticket}", ticket,
+	)
+
+	tmpl := settings.Template
+	if !strings.Contains(tmpl, "{subject}") {
+		tmpl += " {subject}"
+	}
+	before, after, _ := strings.Cut(tmpl, "{subject}")
+	before = tidyTemplate(before, replacer)
+	after = tidyTemplate(after, replacer)
+	if before != "" {
+		before += " "
+	}
+	if after != "" && subject != "" {
+		after = " " + after
+	}
+	return before + subject + after
+}
+
+// cutEmojiPrefix splits the subject before the position of the emoji: the
+// ticket key for "ticket", the [scope] for "scope". The prefix is empty when
+// the subject does not start with it.
+func cutEmojiPrefix(line string) (prefix, rest string) {
+	var re *regexp.Regexp
+	switch settings.EmojiPosition {
+	case positionTicket:
+		if _reTicketPrefix == nil {
+			ticket := settings.TicketPattern
+			if ticket == nil {
+				ticket = reDefaultTicket
+			}
+			_reTicketPrefix = regexp.MustCompile(`^\[?(?:` + ticket.String() + `)\]?:?\s*`)
+		}
+		re = _reTicketPrefix
+	case positionScope:
+		re = reScopePrefix
+	default:
+		return "", line
+	}
+	loc := re.FindStringIndex(line)
+	if loc == nil {
+		return "", line
+	}
+	return line[:loc[1]], line[loc[1]:]
+}
+
+// subjectEmoji returns the emoji at the emoji-position of the subject
+func subjectEmoji(line string) (string, bool) {
+	emojis := subjectEmojis(line)
+	if len(emojis) == 0 {
+		return "", false
+	}
+	return emojis[0], true
+}
+
+// subjectEmojis returns the adjacent emojis at the emoji-position of the
+// subject: the first one is the primary type, the others are secondary tags
+func subjectEmojis(line string) (emojis []string) {
+	if settings.EmojiPosition == positionEnd {
+		line = strings.TrimSpace(line)
+		for {
+			emoji, ok := trailingEmoji(line)
+			if !ok {
+				return emojis
+			}
+			emojis = append([]string{emoji}, emojis...)
+			line = strings.TrimSuffix(line, emoji)
+		}
+	}
+	_, rest := cutEmojiPrefix(line)
+	for {
+		emoji, ok := leadingEmoji(rest)
+		if !ok {
+			return emojis
+		}
+		emojis = append(emojis, emoji)
+		rest = rest[len(emoji):]
+	}
+}
+
+// subjectTypes returns the distinct known types of the subject emojis, the
+// primary type first
+func subjectTypes(line string) (types []*Type) {
+	for _, emoji := range subjectEmojis(line) {
+		if typ := mapIcons[normalizeEmoji(emoji)]; typ != nil && !slices.Contains(types, typ) {
+			types = append(types, typ)
+		}
+	}
+	return types
+}
+
+// replaceEmojis replaces the emojis at the emoji-position of the subject, or
+// inserts them when the subject has none
+func replaceEmojis(line, emoji string) string {
+	emoji = outputEmoji(emoji)
+	if settings.EmojiPosition == positionEnd {
+		line = strings.TrimSpace(line)
+		olds := subjectEmojis(line)
+		// the last emoji first, the others end the line once it is cut
+		for i := len(olds) - 1; i >= 0; i-- {
+			line = strings.TrimSuffix(line, olds[i])
+		}
+		return insertEmoji(line, emoji)
+	}
+	prefix, rest := cutEmojiPrefix(line)
+	for _, old := range subjectEmojis(line) {
+		rest = strings.TrimPrefix(rest, old)
+	}
+	return insertEmoji(prefix+strings.TrimLeft(rest, " "), emoji)
+}
+
+// insertEmoji adds the emoji to the subject at the emoji-position
+func insertEmoji(line, emoji string) string {
+	if settings.EmojiPosition == positionEnd {
+		return strings.TrimRight(line, " ") + " " + emoji
+	}
+	prefix, rest := cutEmojiPrefix(line)
+	if prefix != "" && !strings.HasSuffix(prefix, " ") {
+		prefix += " "
+	}
+	return prefix + emoji + " " + rest
+}
+
+// tidyTemplate renders the tokens of the template. The words whose tokens
+// rendered empty are removed with their punctuation ("[{scope}]",
+// "{ticket}:"), and so are the separators next to them: "{ticket} - " renders
+// as "" without ticket and as "PROJ-1 - " with one.
+func tidyTemplate(tmpl string, replacer *strings.Replacer) string {
+	words := strings.Fields(tmpl)
+	rendered := make([]string, len(words))
+	emptied := make([]bool, len(words)) // the tokens of the word rendered empty
+	for i, word := range words {
+		rendered[i] = replacer.Replace(word)
+		tokens := reTemplateToken.FindAllString(word, -1)
+		if len(tokens) == 0 || slices.ContainsFunc(tokens, func(token string) bool { return replacer.Replace(token) != "" }) {
+			continue
+		}
+		rendered[i] = reEmptyBrackets.ReplaceAllString(rendered[i], "")
+		emptied[i] = isPunctOnly(rendered[i])
+	}
+
+	var out []string
+	for i, word := range rendered {
+		separator := !reTemplateToken.MatchString(words[i]) && isPunctOnly(word)
+		switch {
+		case emptied[i]:
+		case separator && i > 0 && emptied[i-1]:
+		case separator && i+1 < len(words) && emptied[i+1]:
+		default:
+			out = append(out, word)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// isPunctOnly reports whether the word has only punctuation like "-", ":" or
+// "|", or nothing
+func isPunctOnly(word string) bool {
+	return strings.IndexFunc(word, func(r rune) bool {
+		return !unicode.IsPunct(r) && !unicode.Is(unicode.Sm, r)
+	}) < 0
+}
+
+// extractFromBranch returns the first submatch of the pattern in the branch
+// name, or the whole match if the pattern has no group.
+func extractFromBranch(re *regexp.Regexp, branch string) string {
+	if re == nil || branch == "" {
+		return ""
+	}
+	match := re.FindStringSubmatch(branch)
+	switch {
+	case len(match) == 0:
+		return ""
+	case len(match) > 1:
+		return match[1]
+	default:
+		return match[0]
+	}
+}
+
+// formatMsgFile formats the first line of a message file that is not empty
+// and not a comment
+func formatMsgFile(emoji string, typ *Type, dataStr string) string {
+	lines := strings.Split(dataStr, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines[i] = formatSubject(emoji, typ, line)
+		return strings.Join(lines, "\n")
+	}
+	return formatSubject(emoji, typ, "") + "\n" + dataStr
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTidyTemplate(t *testing.T) {
+	tests := []struct {
+		tmpl   string
+		ticket string
+		scope  string
+		want   string
+	}{
+		{"{emoji} {ticket} - ", "PROJ-1", "", "✨ PROJ-1 -"},
+		{"{emoji} {ticket} - ", "", "", "✨"},
+		{"{emoji} - {ticket} ", "", "", "✨"},
+		{"{emoji} | {scope}: ", "", "api", "✨ | api:"},
+		{"{ticket}: ", "PROJ-1", "", "PROJ-1:"},
+		{"{ticket}: ", "", "", ""},
+		{"{emoji} [{scope}] ", "", "api", "✨ [api]"},
+		{"{emoji} [{scope}] ", "", "", "✨"},
+		{"{emoji} ({ticket}) ", "", "", "✨"},
+		{"{emoji} [{scope}] {ticket} - ", "PROJ-1", "", "✨ PROJ-1 -"},
+		{"{emoji} [{scope}] {ticket} - ", "", "api", "✨ [api]"},
+	}
+	for _, tt := range tests {
+		replacer := strings.NewReplacer(
+			"{emoji}", "✨",
+			"{type}", "feat",
+			"{scope}", tt.scope,
+			"{ticket}", tt.ticket,
+		)
+		if got := tidyTemplate(tt.tmpl, replacer); got != tt.want {
+			t.Errorf("tidyTemplate(%q) ticket=%q scope=%q = %q, want %q", tt.tmpl, tt.ticket, tt.scope, got, tt.want)
+		}
+	}
+}
+
+func TestReplaceEmojisAtEnd(t *testing.T) {
+	defer func(old Settings) { settings = old }(settings)
+	settings = defaultSettings()
+	settings.EmojiPosition = positionEnd
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"fix it", "fix it ✨"},
+		{"fix it 🐛", "fix it ✨"},
+		{"fix it 🐛🧪", "fix it ✨"},
+		{"fix it 🐛🧪🔥", "fix it ✨"},
+		{"fix 🐛 it 🧪", "fix 🐛 it ✨"},
+	}
+	for _, tt := range tests {
+		if got := replaceEmojis(tt.line, "✨"); got != tt.want {
+			t.Errorf("replaceEmojis(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const trailerCoAuthoredBy = "Co-authored-by"
+
+// pairTrailers replaces "--pair alice,bob" with the Co-authored-by trailers
+// of the team members in the [git.emoji.team] section of emoji.config:
+//
+//	git commit --pair alice -m msg
+//	git commit --trailer "Co-authored-by: Alice Smith <alice@example.com>" -m msg
+func pairTrailers(args []string) []string {
+	var out, trailers []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			out = append(out, args[i:]...)
+			break
+		}
+		var names string
+		switch {
+		case arg == "--pair":
+			if i+1 >= len(args) {
+				fatalf("missing value for --pair")
+			}
+			i++
+			names = args[i]
+		case strings.HasPrefix(arg, "--pair="):
+			names = strings.TrimPrefix(arg, "--pair=")
+		default:
+			out = append(out, arg)
+			continue
+		}
+		for _, name := range splitList(names) {
+			ident, ok := settings.Team[name]
+			if !ok {
+				fatalf("unknown --pair %q, add it to the [git.emoji.team] section of emoji.config", name)
+			}
+			trailers = append(trailers, "--trailer", trailerCoAuthoredBy+": "+ident)
+		}
+	}
+	if len(trailers) == 0 {
+		return out
+	}
+	// the trailers go after the command name
+	return append(out[:1:1], append(trailers, out[1:]...)...)
+}
+
+// parseTrailers returns the trailers of the message as "Key: value"
+func parseTrailers(msg string) []string {
+	out, errStr, err := execGitInput(msg, "interpret-trailers", "--parse")
+	if err != nil {
+		fatalf("parsing trailers: %v\n%s", err, errStr)
+	}
+	if out == "" {
+		return nil
+	}
+	return strings.Split(out, "\n")
+}
+
+// missingTrailers returns the trailers required by the type that the message
+// does not have, or have without value
+func missingTrailers(typ *Type, msg string) (missing []string) {
+	if typ == nil || len(typ.Trailers) == 0 {
+		return nil
+	}
+	trailers := parseTrailers(msg)
+	for _, key := range typ.Trailers {
+		found := false
+		for _, trailer := range trailers {
+			k, v, _ := strings.Cut(trailer, ":")
+			if strings.EqualFold(strings.TrimSpace(k), key) && strings.TrimSpace(v) != "" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// addTrailers appends the "Key: value" trailers to the message
+func addTrailers(msg string, trailers ...string) string {
+	args := []string{"interpret-trailers"}
+	for _, trailer := range trailers {
+		args = append(args, "--trailer", trailer)
+	}
+	out, errStr, err := execGitInput(msg, args...)
+	if err != nil {
+		fatalf("adding trailers: %v\n%s", err, errStr)
+	}
+	return out + "\n"
+}
+
+// askTrailers asks for the values of the trailers required by the types of
+// the subject and adds them to the message file
+func askTrailers(msgFile string) {
+	msg := string(must(os.ReadFile(msgFile)))
+	firstLine, _ := validateMsgFile(msg)
+	var trailers []string
+	for _, typ := range subjectTypes(firstLine) {
+		for _, key := range missingTrailers(typ, msg) {
+			var value string
+			for value == "" {
+				fmt.Printf("👉 %v requires a %v trailer: ", typ.Name, key)
+				value = strings.TrimSpace(readLine())
+			}
+			trailers = append(trailers, key+": "+value)
+		}
+	}
+	if len(trailers) > 0 {
+		must(0, os.WriteFile(msgFile, []byte(addTrailers(msg, trailers...)), 0644))
+	}
+}
+
+// checkTrailers fails when the cleaned up message does not have the trailers
+// required by the types of its subject
+func checkTrailers(msg string) {
+	if _, ok := cutAutosquashPrefix(strings.TrimSpace(msg)); ok {
+		return // checked on the commit it is squashed into
+	}
+	firstLine, _ := validateMsgFile(msg)
+	for _, typ := range subjectTypes(firstLine) {
+		missing := missingTrailers(typ, msg)
+		if len(missing) > 0 {
+			fatalf("%v commits require the %v trailer, add it with:\n  git commit --trailer \"%v: <text>\"",
+				typ.Name, strings.Join(missing, ", "), missing[0])
+		}
+	}
+}
@@ -138,7 +138,11 @@ func readLine() string {
 	var buf strings.Builder
 	for {
 		var data [1]byte
-		must(os.Stdin.Read(data[:]))
+		if _, err := os.Stdin.Read(data[:]); err == io.EOF {
+			fatalf("no answer, stdin is closed")
+		} else if err != nil {
+			panic(err)
+		}
 		if data[0] == '\n' {
 			break
 		}
@@ -147,13 +151,34 @@ func readLine() string {
 	return buf.String()
 }
 
-func isOptOut() bool {
-	path := rootRepoDir() + "/.git/emoji.not"
-	_, err := os.Stat(path)
-	if err == nil {
-		debugf(".git/emoji.not exists, use original git")
+// shortSha abbreviates a commit hash to 7 characters
+func shortSha(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+// writeFileAtomic writes to a temporary file in the same directory, then
+// renames it over the target, so readers never see a partially written file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
 	}
-	return err == nil
+	return os.Rename(tmp.Name(), path)
 }
 
 func must[T any](v T, err error) T {